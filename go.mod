@@ -4,6 +4,7 @@ require (
 	github.com/aws/aws-sdk-go v1.25.49
 	github.com/hashicorp/terraform v0.12.3
 	github.com/secrethub/secrethub-go v0.32.1
+	gopkg.in/yaml.v2 v2.2.2
 )
 
 go 1.13
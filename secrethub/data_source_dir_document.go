@@ -0,0 +1,103 @@
+package secrethub
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceDirDocument() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDirDocumentRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the directory to render. All secrets in the directory and its subdirectories are included.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "dotenv",
+				ValidateFunc: validation.StringInSlice([]string{"dotenv", "json", "yaml"}, false),
+				Description:  "The format of the document: `dotenv`, `json` or `yaml`.",
+			},
+			"key_case": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "upper",
+				ValidateFunc: validation.StringInSlice([]string{"upper", "lower", "preserve"}, false),
+				Description:  "The case of the keys: `upper`, `lower` or `preserve`.",
+			},
+			"separator": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "_",
+				Description: "The separator that replaces the slashes in the relative paths of the secrets to build the keys. In dotenv documents, dashes and dots are replaced by underscores.",
+			},
+			"document": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The rendered document.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys of the document, in alphabetical order.",
+			},
+		},
+	}
+}
+
+func dataSourceDirDocumentRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Get("path").(string)
+	format := d.Get("format").(string)
+	keyCase := d.Get("key_case").(string)
+	separator := d.Get("separator").(string)
+
+	secrets, err := readDirSecrets(client.Dirs(), client.Secrets(), path, -1)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(secrets))
+	relativePaths := make(map[string]string, len(secrets))
+	for relativePath, data := range secrets {
+		key := dirDocumentKey(relativePath, keyCase, separator)
+		if format == "dotenv" {
+			key, err = dotEnvKey(key)
+			if err != nil {
+				return fmt.Errorf("cannot render secret %s: %s", relativePath, err)
+			}
+		}
+		if other, ok := relativePaths[key]; ok {
+			return fmt.Errorf("secrets %s and %s both result in key %s", other, relativePath, key)
+		}
+		relativePaths[key] = relativePath
+		values[key] = string(data)
+	}
+
+	document, err := renderDirDocument(values, format)
+	if err != nil {
+		return err
+	}
+
+	err = d.Set("document", document)
+	if err != nil {
+		return err
+	}
+	err = d.Set("keys", sortedKeys(values))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	return nil
+}
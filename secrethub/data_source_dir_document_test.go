@@ -0,0 +1,60 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceDirDocument(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path = "%v"
+		}
+
+		resource "secrethub_dir" "db" {
+			path = "${secrethub_dir.%v.path}/db"
+		}
+
+		resource "secrethub_secret" "user" {
+			path  = "${secrethub_dir.db.path}/user"
+			value = "admin"
+		}
+
+		resource "secrethub_secret" "api_key" {
+			path  = "${secrethub_dir.%v.path}/api_key"
+			value = "secretkey"
+		}
+
+		data "secrethub_dir_document" "dotenv" {
+			path = secrethub_dir.%v.path
+
+			depends_on = [secrethub_secret.user, secrethub_secret.api_key]
+		}
+
+		data "secrethub_dir_document" "json" {
+			path      = secrethub_dir.%v.path
+			format    = "json"
+			key_case  = "lower"
+			separator = "."
+
+			depends_on = [secrethub_secret.user, secrethub_secret.api_key]
+		}
+	`, testAcc.dirName, testAcc.dirPath, testAcc.dirName, testAcc.dirName, testAcc.dirName, testAcc.dirName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.secrethub_dir_document.dotenv", "document", "API_KEY=secretkey\nDB_USER=admin\n"),
+					resource.TestCheckResourceAttr("data.secrethub_dir_document.dotenv", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.secrethub_dir_document.json", "document", "{\n  \"api_key\": \"secretkey\",\n  \"db.user\": \"admin\"\n}\n"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package secrethub
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceEnv() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnvRead,
+		Schema: map[string]*schema.Schema{
+			"env": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The environment variables to resolve. Values of the form `secrethub://path[:version]` are replaced by the contents of the secret, other values are passed through unchanged.",
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The environment variables with all secret references resolved.",
+			},
+		},
+	}
+}
+
+func dataSourceEnvRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	env := d.Get("env").(map[string]interface{})
+	resolver := newSecretResolver(client.Secrets())
+
+	values := make(map[string]string, len(env))
+	for name, value := range env {
+		resolved, err := resolver.resolveReference(value.(string))
+		if err != nil {
+			return fmt.Errorf("cannot resolve environment variable %s: %s", name, err)
+		}
+		values[name] = resolved
+	}
+
+	err := d.Set("values", values)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(inputsID("", env))
+
+	return nil
+}
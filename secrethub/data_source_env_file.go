@@ -0,0 +1,103 @@
+package secrethub
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceEnvFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnvFileRead,
+		Schema: map[string]*schema.Schema{
+			"filename": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"content"},
+				Description:   "The path to the secrethub.env file to read. Exactly one of `filename` or `content` must be defined.",
+			},
+			"content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename"},
+				Description:   "The contents of a secrethub.env file. Exactly one of `filename` or `content` must be defined.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "auto",
+				ValidateFunc: validation.StringInSlice([]string{"auto", "yaml", "dotenv"}, false),
+				Description:  "The format of the file: `yaml`, `dotenv` or `auto`, which detects the format from the contents.",
+			},
+			"vars": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Variables to use in the paths of the `{{ path }}` placeholders, referred to as `${name}`.",
+			},
+			"env": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The environment variables defined in the file, with all secret references resolved.",
+			},
+		},
+	}
+}
+
+func dataSourceEnvFileRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	content := d.Get("content").(string)
+	filename := d.Get("filename").(string)
+	if filename == "" && content == "" {
+		return fmt.Errorf("exactly one of 'filename' or 'content' must be defined")
+	}
+	if filename != "" {
+		raw, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		content = string(raw)
+	}
+
+	env, err := parseEnvFile(content, d.Get("format").(string))
+	if err != nil {
+		return fmt.Errorf("cannot parse env file: %s", err)
+	}
+
+	rawVars := d.Get("vars").(map[string]interface{})
+	vars := make(map[string]string, len(rawVars))
+	for name, value := range rawVars {
+		vars[name] = value.(string)
+	}
+
+	// Values are either a single secrethub://path reference or templates with {{ path }} placeholders.
+	resolver := newSecretResolver(client.Secrets())
+	for name, value := range env {
+		var resolved string
+		if strings.HasPrefix(value, secretReferencePrefix) {
+			resolved, err = resolver.resolveReference(value)
+		} else {
+			resolved, err = renderSecretTemplate(value, vars, resolver.resolve)
+		}
+		if err != nil {
+			return fmt.Errorf("cannot resolve environment variable %s: %s", name, err)
+		}
+		env[name] = resolved
+	}
+
+	err = d.Set("env", env)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(inputsID(content, rawVars))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceEnvFile(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			value = "secretpassword"
+		}
+
+		data "secrethub_env_file" "yaml" {
+			content = <<EOT
+environment:
+  DB_USER: admin
+  DB_PASSWORD: "{{ $${repo}/%v }}"
+EOT
+			vars = {
+				repo = "%v"
+			}
+
+			depends_on = [secrethub_secret.%v]
+		}
+
+		data "secrethub_env_file" "dotenv" {
+			content = <<EOT
+DB_USER=admin
+DB_PASSWORD=secrethub://${secrethub_secret.%v.path}
+EOT
+		}
+	`, testAcc.secretName, testAcc.secretPath, testAcc.secretName, testAcc.repoPath, testAcc.secretName, testAcc.secretName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.secrethub_env_file.yaml", "env.DB_USER", "admin"),
+					resource.TestCheckResourceAttr("data.secrethub_env_file.yaml", "env.DB_PASSWORD", "secretpassword"),
+					resource.TestCheckResourceAttr("data.secrethub_env_file.dotenv", "env.DB_USER", "admin"),
+					resource.TestCheckResourceAttr("data.secrethub_env_file.dotenv", "env.DB_PASSWORD", "secretpassword"),
+				),
+			},
+		},
+	})
+}
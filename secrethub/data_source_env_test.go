@@ -0,0 +1,53 @@
+package secrethub
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceEnv(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			value = "secretpassword"
+		}
+
+		data "secrethub_env" "test" {
+			env = {
+				DB_PASSWORD    = "secrethub://${secrethub_secret.%v.path}"
+				DB_PASSWORD_V1 = "secrethub://${secrethub_secret.%v.path}:1"
+				DB_USER        = "admin"
+			}
+		}
+	`, testAcc.secretName, testAcc.secretPath, testAcc.secretName, testAcc.secretName)
+
+	configMissing := fmt.Sprintf(`
+		data "secrethub_env" "test" {
+			env = {
+				DB_PASSWORD = "secrethub://%v_missing"
+			}
+		}
+	`, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.secrethub_env.test", "values.DB_PASSWORD", "secretpassword"),
+					resource.TestCheckResourceAttr("data.secrethub_env.test", "values.DB_PASSWORD_V1", "secretpassword"),
+					resource.TestCheckResourceAttr("data.secrethub_env.test", "values.DB_USER", "admin"),
+				),
+			},
+			{
+				Config:      configMissing,
+				ExpectError: regexp.MustCompile("cannot resolve environment variable DB_PASSWORD"),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+package secrethub
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceJSONSecret() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceJSONSecretRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path where the secret is stored. To use a specific version, append the version number to the path, separated by a colon (path:version). Defaults to the latest version.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the secret.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The JSON document stored in the secret.",
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys and values of the JSON document.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys of the JSON document, in alphabetical order.",
+			},
+		},
+	}
+}
+
+func dataSourceJSONSecretRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Get("path").(string)
+
+	secret, err := client.Secrets().Versions().GetWithData(path)
+	if err != nil {
+		return err
+	}
+
+	fields, err := parseJSONSecret(secret.Data)
+	if err != nil {
+		return fmt.Errorf("cannot parse secret %s: %s", path, err)
+	}
+
+	err = d.Set("value", string(secret.Data))
+	if err != nil {
+		return err
+	}
+	err = d.Set("values", fields)
+	if err != nil {
+		return err
+	}
+	err = d.Set("keys", sortedKeys(fields))
+	if err != nil {
+		return err
+	}
+	err = d.Set("version", secret.Version)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceJSONSecret(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			value = jsonencode({
+				host = "db.internal"
+				port = 5432
+			})
+		}
+
+		data "secrethub_json_secret" "%v" {
+			path = secrethub_secret.%v.path
+		}
+	`, testAcc.secretName, testAcc.secretPath, testAcc.secretName, testAcc.secretName)
+
+	dataSourceName := fmt.Sprintf("data.secrethub_json_secret.%v", testAcc.secretName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "values.host", "db.internal"),
+					resource.TestCheckResourceAttr(dataSourceName, "values.port", "5432"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "keys.0", "host"),
+				),
+			},
+		},
+	})
+}
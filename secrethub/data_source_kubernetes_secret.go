@@ -0,0 +1,206 @@
+package secrethub
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/secrethub/secrethub-go/internals/api"
+	"gopkg.in/yaml.v2"
+)
+
+// kubernetesNamePattern matches valid names of Kubernetes objects (DNS subdomains).
+var kubernetesNamePattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?$`)
+
+func dataSourceKubernetesSecret() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKubernetesSecretRead,
+		Schema: map[string]*schema.Schema{
+			"dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"paths"},
+				Description:   "The path of a directory of which all secrets are included, excluding its subdirectories. Exactly one of `dir` or `paths` must be defined.",
+			},
+			"paths": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"dir"},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "The paths of the secrets to include. To use a specific version, append the version to the path, separated by a colon (path:version). Exactly one of `dir` or `paths` must be defined.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(kubernetesNamePattern, "must be a valid Kubernetes object name: lowercase alphanumeric characters, '-' or '.'"),
+				Description:  "The name of the Kubernetes Secret.",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The namespace of the Kubernetes Secret.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels of the Kubernetes Secret.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Opaque",
+				Description: "The type of the Kubernetes Secret.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "yaml",
+				ValidateFunc: validation.StringInSlice([]string{"yaml", "json"}, false),
+				Description:  "The format of the manifest: `yaml` or `json`.",
+			},
+			"manifest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The manifest of the Kubernetes Secret.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys of the data of the Kubernetes Secret, in alphabetical order.",
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesSecretRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	dir := d.Get("dir").(string)
+	paths := d.Get("paths").([]interface{})
+	if dir == "" && len(paths) == 0 {
+		return fmt.Errorf("exactly one of 'dir' or 'paths' must be defined")
+	}
+
+	// The secrets are keyed by their name.
+	data := make(map[string][]byte)
+	if dir != "" {
+		secrets, err := readDirSecrets(client.Dirs(), client.Secrets(), dir, 1)
+		if err != nil {
+			return err
+		}
+		data = secrets
+	}
+	for _, rawPath := range paths {
+		path, version, err := parseSecretVersionPath(rawPath.(string), "")
+		if err != nil {
+			return err
+		}
+		secretPath, err := api.NewSecretPath(path)
+		if err != nil {
+			return err
+		}
+		name := secretPath.GetSecret()
+		if _, ok := data[name]; ok {
+			return fmt.Errorf("multiple secrets with name %s: keys of a Kubernetes Secret must be unique", name)
+		}
+
+		secret, err := getSecretVersion(client.Secrets(), path, version)
+		if err != nil {
+			return err
+		}
+		data[name] = secret.Data
+	}
+
+	labels := make(map[string]string)
+	for key, value := range d.Get("labels").(map[string]interface{}) {
+		labels[key] = value.(string)
+	}
+
+	name := d.Get("name").(string)
+	namespace := d.Get("namespace").(string)
+	manifest, err := kubernetesSecretManifest(kubernetesObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    labels,
+	}, d.Get("type").(string), data, d.Get("format").(string))
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	err = d.Set("manifest", manifest)
+	if err != nil {
+		return err
+	}
+	err = d.Set("keys", keys)
+	if err != nil {
+		return err
+	}
+
+	if namespace != "" {
+		d.SetId(namespace + "/" + name)
+	} else {
+		d.SetId(name)
+	}
+
+	return nil
+}
+
+// kubernetesSecret is a v1 Secret of the Kubernetes API.
+type kubernetesSecret struct {
+	APIVersion string               `json:"apiVersion" yaml:"apiVersion"`
+	Kind       string               `json:"kind" yaml:"kind"`
+	Metadata   kubernetesObjectMeta `json:"metadata" yaml:"metadata"`
+	Type       string               `json:"type" yaml:"type"`
+	Data       map[string]string    `json:"data" yaml:"data"`
+}
+
+type kubernetesObjectMeta struct {
+	Name      string            `json:"name" yaml:"name"`
+	Namespace string            `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+}
+
+// kubernetesSecretManifest returns the manifest of a Kubernetes Secret with the given data in the given format: yaml or json.
+func kubernetesSecretManifest(metadata kubernetesObjectMeta, secretType string, data map[string][]byte, format string) (string, error) {
+	secret := kubernetesSecret{
+		APIVersion: "v1",
+		Kind:       "Secret",
+		Metadata:   metadata,
+		Type:       secretType,
+		Data:       make(map[string]string, len(data)),
+	}
+	for key, value := range data {
+		secret.Data[key] = base64.StdEncoding.EncodeToString(value)
+	}
+
+	switch format {
+	case "yaml":
+		manifest, err := yaml.Marshal(secret)
+		if err != nil {
+			return "", err
+		}
+		return string(manifest), nil
+	case "json":
+		manifest, err := json.MarshalIndent(secret, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(manifest) + "\n", nil
+	default:
+		return "", fmt.Errorf("unknown format: %s", format)
+	}
+}
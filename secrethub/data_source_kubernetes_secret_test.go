@@ -0,0 +1,94 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestAccDataSourceKubernetesSecret(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path = "%v"
+		}
+
+		resource "secrethub_secret" "password" {
+			path  = "${secrethub_dir.%v.path}/password"
+			value = "secretpassword"
+		}
+
+		data "secrethub_kubernetes_secret" "dir" {
+			dir       = secrethub_dir.%v.path
+			name      = "db"
+			namespace = "app"
+
+			depends_on = [secrethub_secret.password]
+		}
+
+		data "secrethub_kubernetes_secret" "paths" {
+			paths  = ["${secrethub_secret.password.path}:1"]
+			name   = "db"
+			format = "json"
+		}
+	`, testAcc.dirName, testAcc.dirPath, testAcc.dirName, testAcc.dirName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.secrethub_kubernetes_secret.dir", "manifest", "apiVersion: v1\nkind: Secret\nmetadata:\n  name: db\n  namespace: app\ntype: Opaque\ndata:\n  password: c2VjcmV0cGFzc3dvcmQ=\n"),
+					resource.TestCheckResourceAttr("data.secrethub_kubernetes_secret.paths", "keys.0", "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestKubernetesSecretManifest(t *testing.T) {
+	metadata := kubernetesObjectMeta{
+		Name:   "db",
+		Labels: map[string]string{"app": "web"},
+	}
+	data := map[string][]byte{
+		"user":     []byte("admin"),
+		"password": []byte("secretpassword"),
+	}
+
+	manifest, err := kubernetesSecretManifest(metadata, "Opaque", data, "json")
+	assert.OK(t, err)
+	assert.Equal(t, manifest, `{
+  "apiVersion": "v1",
+  "kind": "Secret",
+  "metadata": {
+    "name": "db",
+    "labels": {
+      "app": "web"
+    }
+  },
+  "type": "Opaque",
+  "data": {
+    "password": "c2VjcmV0cGFzc3dvcmQ=",
+    "user": "YWRtaW4="
+  }
+}
+`)
+
+	manifest, err = kubernetesSecretManifest(metadata, "Opaque", data, "yaml")
+	assert.OK(t, err)
+	assert.Equal(t, manifest, `apiVersion: v1
+kind: Secret
+metadata:
+  name: db
+  labels:
+    app: web
+type: Opaque
+data:
+  password: c2VjcmV0cGFzc3dvcmQ=
+  user: YWRtaW4=
+`)
+}
@@ -1,7 +1,17 @@
 package secrethub
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/secrethub/secrethub-go/internals/api"
+	"github.com/secrethub/secrethub-go/pkg/secrethub"
 )
 
 func dataSourceSecret() *schema.Resource {
@@ -11,18 +21,75 @@ func dataSourceSecret() *schema.Resource {
 			"path": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The path where the secret is stored. To use a specific version, append the version number to the path, separated by a colon (path:version). Defaults to the latest version.",
+				Description: "The path where the secret is stored. Use `version` to read a specific version.",
 			},
 			"version": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "The version of the secret.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateSecretVersion,
+				Description:  "The version of the secret to read: a version number, `latest` or `previous`. Defaults to `latest`. After reading, this is set to the resolved version number.",
 			},
 			"value": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
-				Description: "The secret contents.",
+				Description: "The secret contents. Empty when the secret contains binary data, use `value_base64` instead.",
+			},
+			"value_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded secret contents.",
+			},
+			"optional": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the secret is allowed to not exist. When set to `true`, a missing secret results in `default` instead of an error.",
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The value to use when the secret does not exist and `optional` is set to `true`.",
+			},
+			"exists": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret exists.",
+			},
+			"wait_for": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"optional"},
+				Description:   "Wait until the secret exists, or has reached a minimum version, before reading it.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "10s",
+							ValidateFunc: validateDuration,
+							Description:  "How often to check the secret, as a duration such as `10s` or `1m`.",
+						},
+						"timeout": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "5m",
+							ValidateFunc: validateDuration,
+							Description:  "How long to wait before failing, as a duration such as `30s` or `5m`.",
+						},
+						"min_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The version the secret must have reached. Defaults to 1, which waits until the secret exists.",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -32,23 +99,166 @@ func dataSourceSecretRead(d *schema.ResourceData, m interface{}) error {
 	provider := m.(providerMeta)
 	client := *provider.client
 
-	path := d.Get("path").(string)
+	path, version, err := parseSecretVersionPath(d.Get("path").(string), d.Get("version").(string))
+	if err != nil {
+		return err
+	}
+
+	if waitFor := d.Get("wait_for").([]interface{}); len(waitFor) > 0 {
+		err = waitForSecret(provider.stopCtx, client.Secrets(), path, waitFor[0].(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+	}
+
+	secret, err := getSecretVersion(client.Secrets(), path, version)
+	if api.IsErrNotFound(err) && d.Get("optional").(bool) {
+		// Only a missing secret falls back to the default, other errors such as missing permissions still fail.
+		err = setSecretData(d, "value", []byte(d.Get("default").(string)))
+		if err != nil {
+			return err
+		}
+		err = d.Set("version", "0")
+		if err != nil {
+			return err
+		}
+		err = d.Set("exists", false)
+		if err != nil {
+			return err
+		}
 
-	secret, err := client.Secrets().Versions().GetWithData(path)
+		d.SetId(path)
+
+		return nil
+	}
 	if err != nil {
 		return err
 	}
 
-	err = d.Set("value", string(secret.Data))
+	err = setSecretData(d, "value", secret.Data)
+	if err != nil {
+		return err
+	}
+	err = d.Set("version", strconv.Itoa(secret.Version))
 	if err != nil {
 		return err
 	}
-	err = d.Set("version", secret.Version)
+	err = d.Set("exists", true)
 	if err != nil {
 		return err
 	}
 
-	d.SetId(path)
+	d.SetId(fmt.Sprintf("%s:%d", path, secret.Version))
 
 	return nil
 }
+
+// parseSecretVersionPath splits the version from a path of the form path:version and
+// returns it, or the given version when the path has no version. The version defaults to latest.
+// Setting the version both in the path and separately is not allowed.
+func parseSecretVersionPath(path string, version string) (string, string, error) {
+	if i := strings.LastIndex(path, ":"); i != -1 {
+		if version != "" {
+			return "", "", fmt.Errorf("cannot use version %s together with a version in path %s", version, path)
+		}
+		path, version = path[:i], path[i+1:]
+	}
+
+	if version == "" {
+		version = "latest"
+	}
+
+	_, errs := validateSecretVersion(version, "version")
+	if len(errs) > 0 {
+		return "", "", errs[0]
+	}
+
+	return path, version, api.ValidateSecretPath(path)
+}
+
+// getSecretVersion gets the given version of the secret at the given path, including its data.
+func getSecretVersion(secrets secrethub.SecretService, path string, version string) (*api.SecretVersion, error) {
+	switch version {
+	case "latest":
+		return secrets.Versions().GetWithData(path)
+	case "previous":
+		secret, err := secrets.Get(path)
+		if err != nil {
+			return nil, err
+		}
+		if secret.LatestVersion < 2 {
+			return nil, fmt.Errorf("secret %s has no previous version", path)
+		}
+		return secrets.Versions().GetWithData(fmt.Sprintf("%s:%d", path, secret.LatestVersion-1))
+	default:
+		return secrets.Versions().GetWithData(path + ":" + version)
+	}
+}
+
+// waitForSecret polls the secret at the given path until it has reached the minimum version
+// in the given wait_for settings. It returns an error when the timeout expires or ctx is cancelled.
+func waitForSecret(ctx context.Context, secrets secrethub.SecretService, path string, settings map[string]interface{}) error {
+	interval, err := time.ParseDuration(settings["interval"].(string))
+	if err != nil {
+		return err
+	}
+	timeout, err := time.ParseDuration(settings["timeout"].(string))
+	if err != nil {
+		return err
+	}
+	minVersion := settings["min_version"].(int)
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	latest := 0
+	for {
+		secret, err := secrets.Get(path)
+		if err == nil {
+			latest = secret.LatestVersion
+			if latest >= minVersion {
+				return nil
+			}
+		} else if !api.IsErrNotFound(err) {
+			return err
+		}
+
+		log.Printf("[DEBUG] Waiting for secret %s to reach version %d, latest version is %d", path, minVersion, latest)
+
+		select {
+		case <-ticker.C:
+		case <-deadline.C:
+			if latest == 0 {
+				return fmt.Errorf("timed out after %s waiting for secret %s to exist", timeout, path)
+			}
+			return fmt.Errorf("timed out after %s waiting for secret %s to reach version %d, latest version is %d", timeout, path, minVersion, latest)
+		case <-ctx.Done():
+			return fmt.Errorf("stopped waiting for secret %s: %s", path, ctx.Err())
+		}
+	}
+}
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s must be a duration such as 30s or 5m: %s", k, err)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("%s must be a positive duration, got: %s", k, v)}
+	}
+	return nil, nil
+}
+
+func validateSecretVersion(v interface{}, k string) ([]string, []error) {
+	version := v.(string)
+	if version == "latest" || version == "previous" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(version)
+	if err != nil || n < 1 {
+		return nil, []error{fmt.Errorf("%s must be a version number, latest or previous, got: %s", k, version)}
+	}
+	return nil, nil
+}
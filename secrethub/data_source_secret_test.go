@@ -2,6 +2,7 @@ package secrethub
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -92,3 +93,158 @@ func TestAccDataSourceSecret_PathVersioned(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDataSourceSecret_Optional(t *testing.T) {
+	config := fmt.Sprintf(`
+		data "secrethub_secret" "%v" {
+			path     = "%v_missing"
+			optional = true
+			default  = "defaultpassword"
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("data.secrethub_secret.%v", testAcc.secretName),
+						"exists",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("data.secrethub_secret.%v", testAcc.secretName),
+						"value",
+						"defaultpassword",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSecret_Version(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			value = "secretpasswordv2"
+		}
+
+		data "secrethub_secret" "%v_v1" {
+			path    = secrethub_secret.%v.path
+			version = "1"
+		}
+
+		data "secrethub_secret" "%v_previous" {
+			path    = secrethub_secret.%v.path
+			version = "previous"
+		}
+	`, testAcc.secretName, testAcc.secretPath, testAcc.secretName, testAcc.secretName, testAcc.secretName, testAcc.secretName)
+
+	configInit := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			value = "secretpasswordv1"
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	configConflict := fmt.Sprintf(`
+		data "secrethub_secret" "%v" {
+			path    = "%v:1"
+			version = "1"
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: configInit,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("data.secrethub_secret.%v_v1", testAcc.secretName),
+						"value",
+						"secretpasswordv1",
+					),
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("data.secrethub_secret.%v_v1", testAcc.secretName),
+						"id",
+						testAcc.secretPath+":1",
+					),
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("data.secrethub_secret.%v_previous", testAcc.secretName),
+						"value",
+						"secretpasswordv1",
+					),
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("data.secrethub_secret.%v_previous", testAcc.secretName),
+						"version",
+						"1",
+					),
+				),
+			},
+			{
+				Config:      configConflict,
+				ExpectError: regexp.MustCompile("cannot use version"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceSecret_WaitFor(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			value = "secretpassword"
+		}
+
+		data "secrethub_secret" "%v" {
+			path = secrethub_secret.%v.path
+
+			wait_for {
+				interval    = "1s"
+				timeout     = "10s"
+				min_version = 1
+			}
+		}
+	`, testAcc.secretName, testAcc.secretPath, testAcc.secretName, testAcc.secretName)
+
+	configTimeout := fmt.Sprintf(`
+		data "secrethub_secret" "%v" {
+			path = "%v_missing"
+
+			wait_for {
+				interval = "1s"
+				timeout  = "3s"
+			}
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("data.secrethub_secret.%v", testAcc.secretName),
+						"value",
+						"secretpassword",
+					),
+				),
+			},
+			{
+				Config:      configTimeout,
+				ExpectError: regexp.MustCompile("timed out after 3s waiting for secret"),
+			},
+		},
+	})
+}
@@ -0,0 +1,110 @@
+package secrethub
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSecretVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecretVersionsRead,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path where the secret is stored.",
+			},
+			"include_values": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to also fetch the contents of every version.",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the secret, sorted from oldest to newest.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version number.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time at which the version was created, in RFC3339 format.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the version: ok or flagged.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The contents of the version. Only set when `include_values` is true.",
+						},
+						"value_base64": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The base64 encoded contents of the version. Only set when `include_values` is true.",
+						},
+					},
+				},
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The latest version of the secret.",
+			},
+		},
+	}
+}
+
+func dataSourceSecretVersionsRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Get("path").(string)
+	includeValues := d.Get("include_values").(bool)
+
+	versions, err := listSecretVersions(client.Secrets(), path, includeValues)
+	if err != nil {
+		return err
+	}
+
+	list := make([]interface{}, len(versions))
+	latest := 0
+	for i, version := range versions {
+		item := map[string]interface{}{
+			"version":    version.Version,
+			"created_at": version.CreatedAt.Format(time.RFC3339),
+			"status":     version.Status,
+		}
+		if includeValues {
+			item["value"] = secretDataString(version.Data)
+			item["value_base64"] = base64.StdEncoding.EncodeToString(version.Data)
+		}
+		list[i] = item
+		latest = version.Version
+	}
+
+	err = d.Set("versions", list)
+	if err != nil {
+		return err
+	}
+	err = d.Set("latest_version", latest)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceSecretVersions(t *testing.T) {
+	configFor := func(value string) string {
+		return fmt.Sprintf(`
+			resource "secrethub_secret" "%v" {
+				path  = "%v"
+				value = "%v"
+			}
+
+			data "secrethub_secret_versions" "%v" {
+				path           = secrethub_secret.%v.path
+				include_values = true
+			}
+		`, testAcc.secretName, testAcc.secretPath, value, testAcc.secretName, testAcc.secretName)
+	}
+
+	dataSourceName := fmt.Sprintf("data.secrethub_secret_versions.%v", testAcc.secretName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: configFor("secretpasswordv1"),
+			},
+			{
+				Config: configFor("secretpasswordv2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.version", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.value", "secretpasswordv1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.0.status", "ok"),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.1.value", "secretpasswordv2"),
+				),
+			},
+		},
+	})
+}
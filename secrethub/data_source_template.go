@@ -0,0 +1,60 @@
+package secrethub
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceTemplate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTemplateRead,
+		Schema: map[string]*schema.Schema{
+			"template": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The template to render. Every `{{ path }}` placeholder is replaced by the contents of the secret at that path.",
+			},
+			"vars": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Variables to use in the paths of the placeholders, referred to as `${name}`.",
+			},
+			"rendered": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The rendered template.",
+			},
+		},
+	}
+}
+
+func dataSourceTemplateRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	template := d.Get("template").(string)
+	rawVars := d.Get("vars").(map[string]interface{})
+
+	vars := make(map[string]string, len(rawVars))
+	for name, value := range rawVars {
+		vars[name] = value.(string)
+	}
+
+	resolver := newSecretResolver(client.Secrets())
+	rendered, err := renderSecretTemplate(template, vars, resolver.resolve)
+	if err != nil {
+		return fmt.Errorf("cannot render template: %s", err)
+	}
+
+	err = d.Set("rendered", rendered)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(inputsID(template, rawVars))
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package secrethub
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceTemplate(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			value = "secretpassword"
+		}
+
+		data "secrethub_template" "test" {
+			template = "password: {{ $${repo}/%v }}"
+			vars = {
+				repo = "%v"
+			}
+
+			depends_on = [secrethub_secret.%v]
+		}
+	`, testAcc.secretName, testAcc.secretPath, testAcc.secretName, testAcc.repoPath, testAcc.secretName)
+
+	configMissing := fmt.Sprintf(`
+		data "secrethub_template" "test" {
+			template = "password: {{ %v_missing }}"
+		}
+	`, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.secrethub_template.test", "rendered", "password: secretpassword"),
+				),
+			},
+			{
+				Config:      configMissing,
+				ExpectError: regexp.MustCompile("cannot render template"),
+			},
+		},
+	})
+}
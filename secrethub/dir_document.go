@@ -0,0 +1,86 @@
+package secrethub
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// dirDocumentKey converts the path of a secret relative to a directory into a document key.
+// The slashes of the path are replaced by the separator and keyCase is one of upper, lower or preserve.
+func dirDocumentKey(relativePath string, keyCase string, separator string) string {
+	key := strings.Replace(relativePath, "/", separator, -1)
+	switch keyCase {
+	case "upper":
+		return strings.ToUpper(key)
+	case "lower":
+		return strings.ToLower(key)
+	default:
+		return key
+	}
+}
+
+// dotEnvKeyReplacer replaces the characters that are common in secret names, but not allowed in environment variable names.
+var dotEnvKeyReplacer = strings.NewReplacer("-", "_", ".", "_")
+
+// dotEnvKeyPattern matches valid environment variable names.
+var dotEnvKeyPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// dotEnvKey converts a document key into a valid environment variable name by replacing dashes and dots by
+// underscores. It returns an error when the key contains other characters that are not allowed.
+func dotEnvKey(key string) (string, error) {
+	converted := dotEnvKeyReplacer.Replace(key)
+	if !dotEnvKeyPattern.MatchString(converted) {
+		return "", fmt.Errorf("%s is not a valid dotenv key: only letters, digits and underscores are allowed and it cannot start with a digit", converted)
+	}
+	return converted, nil
+}
+
+// renderDirDocument renders the given keys and values as a document in the given format: dotenv, json or yaml.
+// The keys are sorted, so the document does not depend on the order of the keys.
+func renderDirDocument(values map[string]string, format string) (string, error) {
+	switch format {
+	case "json":
+		// encoding/json sorts the keys of maps.
+		document, err := json.MarshalIndent(values, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(document) + "\n", nil
+	case "yaml":
+		// yaml.v2 sorts the keys of maps.
+		document, err := yaml.Marshal(values)
+		if err != nil {
+			return "", err
+		}
+		return string(document), nil
+	case "dotenv":
+		keys := make([]string, 0, len(values))
+		for key := range values {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var document strings.Builder
+		for _, key := range keys {
+			fmt.Fprintf(&document, "%s=%s\n", key, dotEnvValue(values[key]))
+		}
+		return document.String(), nil
+	default:
+		return "", fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+// dotEnvValue quotes a value for a dotenv file when it contains characters
+// that would otherwise be interpreted, such as whitespace, quotes or a #.
+func dotEnvValue(value string) string {
+	if value == "" || !strings.ContainsAny(value, " \t\r\n\"'#\\$") {
+		return value
+	}
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "$", `\$`)
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,142 @@
+package secrethub
+
+import (
+	"testing"
+
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestDirDocumentKey(t *testing.T) {
+	cases := map[string]struct {
+		relativePath string
+		keyCase      string
+		separator    string
+		expected     string
+	}{
+		"upper": {
+			relativePath: "db/password",
+			keyCase:      "upper",
+			separator:    "_",
+			expected:     "DB_PASSWORD",
+		},
+		"lower": {
+			relativePath: "DB/Password",
+			keyCase:      "lower",
+			separator:    "_",
+			expected:     "db_password",
+		},
+		"preserve": {
+			relativePath: "db/Password",
+			keyCase:      "preserve",
+			separator:    ".",
+			expected:     "db.Password",
+		},
+		"no separator": {
+			relativePath: "api_key",
+			keyCase:      "upper",
+			separator:    "__",
+			expected:     "API_KEY",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, dirDocumentKey(tc.relativePath, tc.keyCase, tc.separator), tc.expected)
+		})
+	}
+}
+
+func TestDotEnvKey(t *testing.T) {
+	cases := map[string]struct {
+		key      string
+		expected string
+		err      bool
+	}{
+		"valid": {
+			key:      "DB_PASSWORD",
+			expected: "DB_PASSWORD",
+		},
+		"dashes": {
+			key:      "DB-PASSWORD",
+			expected: "DB_PASSWORD",
+		},
+		"dots": {
+			key:      "db.tls.cert",
+			expected: "db_tls_cert",
+		},
+		"leading digit": {
+			key: "1PASSWORD",
+			err: true,
+		},
+		"invalid character": {
+			key: "DB:PASSWORD",
+			err: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			key, err := dotEnvKey(tc.key)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected an error for key %q", tc.key)
+				}
+				return
+			}
+			assert.OK(t, err)
+			assert.Equal(t, key, tc.expected)
+		})
+	}
+}
+
+func TestRenderDirDocument(t *testing.T) {
+	values := map[string]string{
+		"DB_USER":     "admin",
+		"DB_PASSWORD": "p@ss word",
+	}
+
+	cases := map[string]struct {
+		format   string
+		expected string
+	}{
+		"dotenv": {
+			format:   "dotenv",
+			expected: "DB_PASSWORD=\"p@ss word\"\nDB_USER=admin\n",
+		},
+		"json": {
+			format:   "json",
+			expected: "{\n  \"DB_PASSWORD\": \"p@ss word\",\n  \"DB_USER\": \"admin\"\n}\n",
+		},
+		"yaml": {
+			format:   "yaml",
+			expected: "DB_PASSWORD: p@ss word\nDB_USER: admin\n",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			document, err := renderDirDocument(values, tc.format)
+			assert.OK(t, err)
+			assert.Equal(t, document, tc.expected)
+		})
+	}
+}
+
+func TestDotEnvValue(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		expected string
+	}{
+		"plain":     {value: "secret", expected: "secret"},
+		"empty":     {value: "", expected: ""},
+		"multiline": {value: "line1\nline2", expected: `"line1\nline2"`},
+		"quotes":    {value: `say "hi"`, expected: `"say \"hi\""`},
+		"dollar":    {value: "pa$$", expected: `"pa\$\$"`},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, dotEnvValue(tc.value), tc.expected)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package secrethub
+
+import (
+	"strings"
+
+	"github.com/secrethub/secrethub-go/pkg/secrethub"
+)
+
+// readDirSecrets reads the latest version of every secret in the directory at the given path
+// and its subdirectories up to the given depth, where -1 includes all subdirectories and 1 none.
+// The secrets are keyed by their path relative to that directory.
+func readDirSecrets(dirs secrethub.DirService, secrets secrethub.SecretService, path string, depth int) (map[string][]byte, error) {
+	tree, err := dirs.GetTree(path, depth, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rootPath, err := tree.AbsDirPath(tree.RootDir.DirID)
+	if err != nil {
+		return nil, err
+	}
+	prefix := rootPath.String() + "/"
+
+	data := make(map[string][]byte, len(tree.Secrets))
+	for secretID := range tree.Secrets {
+		secretPath, err := tree.AbsSecretPath(secretID)
+		if err != nil {
+			return nil, err
+		}
+
+		version, err := secrets.Versions().GetWithData(secretPath.String())
+		if err != nil {
+			return nil, err
+		}
+
+		data[strings.TrimPrefix(secretPath.String(), prefix)] = version.Data
+	}
+	return data, nil
+}
@@ -0,0 +1,80 @@
+package secrethub
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// parseEnvFile parses the contents of a secrethub.env file in the given format: yaml, dotenv or auto.
+// With auto, the contents are parsed as YAML when they contain an environment map and as dotenv otherwise.
+func parseEnvFile(content string, format string) (map[string]string, error) {
+	switch format {
+	case "yaml":
+		return parseYAMLEnvFile(content)
+	case "dotenv":
+		return parseDotEnvFile(content)
+	case "auto":
+		env, err := parseYAMLEnvFile(content)
+		if err == nil {
+			return env, nil
+		}
+		return parseDotEnvFile(content)
+	default:
+		return nil, fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+// parseYAMLEnvFile parses a YAML file with an environment map of environment variable names to values.
+func parseYAMLEnvFile(content string) (map[string]string, error) {
+	var file struct {
+		Environment map[string]string `yaml:"environment"`
+	}
+	err := yaml.UnmarshalStrict([]byte(content), &file)
+	if err != nil {
+		return nil, err
+	}
+	if file.Environment == nil {
+		return nil, fmt.Errorf("no environment map found")
+	}
+	return file.Environment, nil
+}
+
+// parseDotEnvFile parses a file with a KEY=value pair on every line.
+// Empty lines, comments starting with # and an `export ` prefix are ignored,
+// and values can be surrounded by single or double quotes.
+func parseDotEnvFile(content string) (map[string]string, error) {
+	env := make(map[string]string)
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		i := strings.IndexByte(line, '=')
+		if i == -1 {
+			return nil, fmt.Errorf("line %d: expected KEY=value", n)
+		}
+		key := strings.TrimSpace(line[:i])
+		if key == "" {
+			return nil, fmt.Errorf("line %d: empty key", n)
+		}
+		if _, ok := env[key]; ok {
+			return nil, fmt.Errorf("line %d: duplicate key %s", n, key)
+		}
+
+		value := strings.TrimSpace(line[i+1:])
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		env[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
@@ -0,0 +1,66 @@
+package secrethub
+
+import (
+	"testing"
+
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	cases := map[string]struct {
+		content  string
+		format   string
+		expected map[string]string
+		err      bool
+	}{
+		"yaml": {
+			content:  "environment:\n  DB_USER: admin\n  DB_PASSWORD: \"{{ company/repo/db/password }}\"\n",
+			format:   "yaml",
+			expected: map[string]string{"DB_USER": "admin", "DB_PASSWORD": "{{ company/repo/db/password }}"},
+		},
+		"yaml without environment": {
+			content: "DB_USER: admin\n",
+			format:  "yaml",
+			err:     true,
+		},
+		"dotenv": {
+			content:  "# database\nDB_USER=admin\nexport DB_PASSWORD = {{ company/repo/db/password }}\n\nDB_HOST='localhost'\nDB_NAME=\"app=prod\"\n",
+			format:   "dotenv",
+			expected: map[string]string{"DB_USER": "admin", "DB_PASSWORD": "{{ company/repo/db/password }}", "DB_HOST": "localhost", "DB_NAME": "app=prod"},
+		},
+		"dotenv without value": {
+			content: "DB_USER\n",
+			format:  "dotenv",
+			err:     true,
+		},
+		"dotenv duplicate key": {
+			content: "DB_USER=admin\nDB_USER=root\n",
+			format:  "dotenv",
+			err:     true,
+		},
+		"auto yaml": {
+			content:  "environment:\n  DB_USER: admin\n",
+			format:   "auto",
+			expected: map[string]string{"DB_USER": "admin"},
+		},
+		"auto dotenv": {
+			content:  "DB_USER=admin\nDB_PASSWORD=secrethub://company/repo/db/password\n",
+			format:   "auto",
+			expected: map[string]string{"DB_USER": "admin", "DB_PASSWORD": "secrethub://company/repo/db/password"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			env, err := parseEnvFile(tc.content, tc.format)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected an error for %q", tc.content)
+				}
+				return
+			}
+			assert.OK(t, err)
+			assert.Equal(t, env, tc.expected)
+		})
+	}
+}
@@ -0,0 +1,362 @@
+package secrethub
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/secrethub/secrethub-go/pkg/randchar"
+)
+
+// secretGenerator generates secret values according to the settings of a generate block.
+type secretGenerator interface {
+	// Generate returns a newly generated secret value.
+	Generate() ([]byte, error)
+	// Entropy returns an estimate of the entropy of the generated values in bits.
+	Entropy() float64
+}
+
+// newSecretGenerator returns the generator for the given generate block settings.
+func newSecretGenerator(settings map[string]interface{}) (secretGenerator, error) {
+	length := settings["length"].(int)
+	pattern := settings["pattern"].(string)
+	passphraseList := settings["passphrase"].([]interface{})
+	randomBytesList := settings["random_bytes"].([]interface{})
+
+	modes := 0
+	if length > 0 {
+		modes++
+	}
+	if pattern != "" {
+		modes++
+	}
+	if len(passphraseList) > 0 {
+		modes++
+	}
+	if len(randomBytesList) > 0 {
+		modes++
+	}
+	if modes != 1 {
+		return nil, fmt.Errorf("exactly one of 'length', 'pattern', 'passphrase' or 'random_bytes' must be specified in 'generate'")
+	}
+
+	if length == 0 {
+		if settings["use_symbols"].(bool) ||
+			settings["charsets"].(*schema.Set).Len() > 0 ||
+			len(settings["min"].(map[string]interface{})) > 0 ||
+			settings["exclude_characters"].(string) != "" ||
+			settings["exclude_similar"].(bool) {
+			return nil, fmt.Errorf("'use_symbols', 'charsets', 'min', 'exclude_characters' and 'exclude_similar' can only be used together with 'length'")
+		}
+	}
+
+	switch {
+	case pattern != "":
+		return newPatternGenerator(pattern)
+	case len(passphraseList) > 0:
+		passphrase := passphraseList[0].(map[string]interface{})
+		return passphraseGenerator{
+			words:      passphrase["words"].(int),
+			separator:  passphrase["separator"].(string),
+			capitalize: passphrase["capitalize"].(bool),
+		}, nil
+	case len(randomBytesList) > 0:
+		randomBytes := randomBytesList[0].(map[string]interface{})
+		return newRandomBytesGenerator(randomBytes["length"].(int), randomBytes["encoding"].(string))
+	default:
+		return newCharsetGenerator(settings)
+	}
+}
+
+// charsetGenerator generates a random string of a fixed length from a set of characters.
+type charsetGenerator struct {
+	length   int
+	charset  randchar.Charset
+	minRules []charsetMinRule
+	rand     randchar.Rand
+}
+
+// charsetMinRule requires at least n characters of the generated secret to come from the given charset.
+type charsetMinRule struct {
+	n       int
+	charset randchar.Charset
+}
+
+func newCharsetGenerator(settings map[string]interface{}) (secretGenerator, error) {
+	useSymbols := settings["use_symbols"].(bool)
+	length := settings["length"].(int)
+	charsetSet := settings["charsets"].(*schema.Set)
+	charsets := charsetSet.List()
+	charset := randchar.Charset{}
+	if len(charsets) == 0 {
+		charset = randchar.Alphanumeric
+	}
+	if useSymbols {
+		charset = charset.Add(randchar.Symbols)
+	}
+	for _, charsetName := range charsets {
+		set, found := randchar.CharsetByName(charsetName.(string))
+		if !found {
+			return nil, fmt.Errorf("unknown charset: %s", charsetName)
+		}
+		charset = charset.Add(set)
+	}
+
+	// Exclusions are applied after all charsets are combined, so they take precedence over any charset.
+	exclude := randchar.NewCharset(settings["exclude_characters"].(string))
+	if settings["exclude_similar"].(bool) {
+		exclude = exclude.Add(randchar.Similar)
+	}
+	charset = charset.Subtract(exclude)
+	if charset.Size() == 0 {
+		return nil, fmt.Errorf("no characters left to generate a secret from after applying the exclusions")
+	}
+
+	minRuleMap := settings["min"].(map[string]interface{})
+	minRules := make([]charsetMinRule, 0, len(minRuleMap))
+	options := make([]randchar.Option, 0, len(minRuleMap))
+	minTotal := 0
+	for charsetName, min := range minRuleMap {
+		n := min.(int)
+		set, found := randchar.CharsetByName(charsetName)
+		if !found {
+			return nil, fmt.Errorf("unknown charset: %s", charsetName)
+		}
+		set = set.Subtract(exclude)
+		if set.Size() == 0 {
+			return nil, fmt.Errorf("cannot satisfy min rule for charset %s: all of its characters are excluded", charsetName)
+		}
+		minRules = append(minRules, charsetMinRule{n: n, charset: set})
+		options = append(options, randchar.Min(n, set))
+		minTotal += n
+	}
+	if minTotal > length {
+		return nil, fmt.Errorf("the min rules require at least %d characters, which is more than the length of %d", minTotal, length)
+	}
+
+	rand, err := randchar.NewRand(charset, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return charsetGenerator{
+		length:   length,
+		charset:  charset,
+		minRules: minRules,
+		rand:     rand,
+	}, nil
+}
+
+func (g charsetGenerator) Generate() ([]byte, error) {
+	return g.rand.Generate(g.length)
+}
+
+// Entropy estimates the entropy by counting the characters constrained by
+// a min rule as random characters from that rule's charset only.
+func (g charsetGenerator) Entropy() float64 {
+	entropy := 0.0
+	free := g.length
+	for _, rule := range g.minRules {
+		entropy += float64(rule.n) * math.Log2(float64(rule.charset.Size()))
+		free -= rule.n
+	}
+	if free > 0 {
+		entropy += float64(free) * math.Log2(float64(g.charset.Size()))
+	}
+	return entropy
+}
+
+// passphraseGenerator generates a passphrase of randomly chosen words from the EFF short wordlist.
+type passphraseGenerator struct {
+	words      int
+	separator  string
+	capitalize bool
+}
+
+func (g passphraseGenerator) Generate() ([]byte, error) {
+	words := make([]string, g.words)
+	max := big.NewInt(int64(len(effShortWordList)))
+	for i := range words {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return nil, err
+		}
+		word := effShortWordList[n.Int64()]
+		if g.capitalize {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+		words[i] = word
+	}
+	return []byte(strings.Join(words, g.separator)), nil
+}
+
+func (g passphraseGenerator) Entropy() float64 {
+	return float64(g.words) * math.Log2(float64(len(effShortWordList)))
+}
+
+// patternGenerator generates a value that follows a pattern of literal text and
+// placeholders of the form {charset:n}, which are replaced by n random characters from
+// the named charset. Use {{ and }} for literal braces.
+type patternGenerator struct {
+	parts []patternPart
+}
+
+type patternPart struct {
+	literal string
+	charset randchar.Charset
+	n       int
+}
+
+func newPatternGenerator(pattern string) (secretGenerator, error) {
+	var parts []patternPart
+	var literal strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '{' && strings.HasPrefix(pattern[i:], "{{"):
+			literal.WriteByte('{')
+			i++
+		case c == '}' && strings.HasPrefix(pattern[i:], "}}"):
+			literal.WriteByte('}')
+			i++
+		case c == '{':
+			end := strings.IndexByte(pattern[i:], '}')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid pattern %q: unclosed placeholder at position %d", pattern, i)
+			}
+			part, err := parsePatternPlaceholder(pattern[i+1 : i+end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+			}
+			if literal.Len() > 0 {
+				parts = append(parts, patternPart{literal: literal.String()})
+				literal.Reset()
+			}
+			parts = append(parts, part)
+			i += end
+		case c == '}':
+			return nil, fmt.Errorf("invalid pattern %q: unexpected '}' at position %d", pattern, i)
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	if literal.Len() > 0 {
+		parts = append(parts, patternPart{literal: literal.String()})
+	}
+
+	// Without placeholders, the same value would be generated every time.
+	hasPlaceholder := false
+	for _, part := range parts {
+		if part.n > 0 {
+			hasPlaceholder = true
+			break
+		}
+	}
+	if !hasPlaceholder {
+		return nil, fmt.Errorf("invalid pattern %q: no placeholder of the form {charset:n}, so nothing would be generated", pattern)
+	}
+
+	return patternGenerator{parts: parts}, nil
+}
+
+func validatePattern(v interface{}, k string) ([]string, []error) {
+	_, err := newPatternGenerator(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", k, err)}
+	}
+	return nil, nil
+}
+
+func parsePatternPlaceholder(placeholder string) (patternPart, error) {
+	name := strings.TrimSpace(placeholder)
+	n := 1
+	if i := strings.IndexByte(placeholder, ':'); i != -1 {
+		name = strings.TrimSpace(placeholder[:i])
+		var err error
+		n, err = strconv.Atoi(strings.TrimSpace(placeholder[i+1:]))
+		if err != nil || n < 1 {
+			return patternPart{}, fmt.Errorf("invalid length in placeholder {%s}", placeholder)
+		}
+	}
+
+	charset, found := randchar.CharsetByName(name)
+	if !found {
+		return patternPart{}, fmt.Errorf("unknown charset in placeholder {%s}", placeholder)
+	}
+
+	return patternPart{charset: charset, n: n}, nil
+}
+
+func (g patternGenerator) Generate() ([]byte, error) {
+	var value []byte
+	for _, part := range g.parts {
+		if part.n == 0 {
+			value = append(value, part.literal...)
+			continue
+		}
+		rand, err := randchar.NewRand(part.charset)
+		if err != nil {
+			return nil, err
+		}
+		generated, err := rand.Generate(part.n)
+		if err != nil {
+			return nil, err
+		}
+		value = append(value, generated...)
+	}
+	return value, nil
+}
+
+func (g patternGenerator) Entropy() float64 {
+	entropy := 0.0
+	for _, part := range g.parts {
+		if part.n > 0 {
+			entropy += float64(part.n) * math.Log2(float64(part.charset.Size()))
+		}
+	}
+	return entropy
+}
+
+// randomBytesGenerator generates a number of random bytes and encodes them as text.
+type randomBytesGenerator struct {
+	length int
+	encode func([]byte) string
+}
+
+func newRandomBytesGenerator(length int, encoding string) (secretGenerator, error) {
+	var encode func([]byte) string
+	switch encoding {
+	case "hex":
+		encode = hex.EncodeToString
+	case "base64":
+		encode = base64.StdEncoding.EncodeToString
+	case "base64url":
+		encode = base64.RawURLEncoding.EncodeToString
+	default:
+		return nil, fmt.Errorf("unknown encoding: %s", encoding)
+	}
+
+	return randomBytesGenerator{
+		length: length,
+		encode: encode,
+	}, nil
+}
+
+func (g randomBytesGenerator) Generate() ([]byte, error) {
+	data := make([]byte, g.length)
+	_, err := rand.Read(data)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(g.encode(data)), nil
+}
+
+func (g randomBytesGenerator) Entropy() float64 {
+	return float64(8 * g.length)
+}
@@ -0,0 +1,206 @@
+package secrethub
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func charsetSettings(length int, charsets []interface{}, min map[string]interface{}, exclude string, excludeSimilar bool) map[string]interface{} {
+	if min == nil {
+		min = map[string]interface{}{}
+	}
+	return map[string]interface{}{
+		"length":             length,
+		"use_symbols":        false,
+		"charsets":           schema.NewSet(schema.HashString, charsets),
+		"min":                min,
+		"exclude_characters": exclude,
+		"exclude_similar":    excludeSimilar,
+		"pattern":            "",
+		"passphrase":         []interface{}{},
+		"random_bytes":       []interface{}{},
+	}
+}
+
+func TestCharsetGenerator_exclusions(t *testing.T) {
+	cases := map[string]struct {
+		settings map[string]interface{}
+		excluded string
+		err      bool
+	}{
+		"exclude characters": {
+			settings: charsetSettings(64, []interface{}{"all"}, nil, `'"\$`, false),
+			excluded: `'"\$`,
+		},
+		"exclude similar": {
+			settings: charsetSettings(64, nil, nil, "", true),
+			excluded: "iIlL1oO0",
+		},
+		"min after exclusion": {
+			settings: charsetSettings(16, []interface{}{"letters", "numeric"}, map[string]interface{}{"numeric": 4}, "01234", false),
+			excluded: "01234",
+		},
+		"min impossible after exclusion": {
+			settings: charsetSettings(16, []interface{}{"letters", "numeric"}, map[string]interface{}{"numeric": 4}, "0123456789", false),
+			err:      true,
+		},
+		"min total larger than length": {
+			settings: charsetSettings(8, []interface{}{"letters", "numeric"}, map[string]interface{}{"numeric": 5, "letters": 5}, "", false),
+			err:      true,
+		},
+		"everything excluded": {
+			settings: charsetSettings(8, []interface{}{"numeric"}, nil, "0123456789", false),
+			err:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			generator, err := newSecretGenerator(tc.settings)
+			if tc.err {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			assert.OK(t, err)
+
+			value, err := generator.Generate()
+			assert.OK(t, err)
+			if strings.ContainsAny(string(value), tc.excluded) {
+				t.Errorf("generated value %q contains excluded characters %q", value, tc.excluded)
+			}
+		})
+	}
+}
+
+func TestPatternGenerator(t *testing.T) {
+	cases := map[string]struct {
+		pattern string
+		regex   string
+		entropy float64
+		err     bool
+	}{
+		"literal only": {
+			pattern: "static",
+			err:     true,
+		},
+		"escaped braces only": {
+			pattern: "{{AKIA}}",
+			err:     true,
+		},
+		"aws access key id": {
+			pattern: "AKIA{uppercase:16}",
+			regex:   `^AKIA[A-Z]{16}$`,
+			entropy: 16 * math.Log2(26),
+		},
+		"single character placeholder": {
+			pattern: "{numeric}-{lowercase:2}",
+			regex:   `^[0-9]-[a-z]{2}$`,
+			entropy: math.Log2(10) + 2*math.Log2(26),
+		},
+		"escaped braces": {
+			pattern: "{{{numeric:3}}}",
+			regex:   `^\{[0-9]{3}\}$`,
+			entropy: 3 * math.Log2(10),
+		},
+		"unknown charset": {
+			pattern: "{unknown:3}",
+			err:     true,
+		},
+		"invalid length": {
+			pattern: "{numeric:x}",
+			err:     true,
+		},
+		"unclosed placeholder": {
+			pattern: "AKIA{uppercase:16",
+			err:     true,
+		},
+		"unexpected closing brace": {
+			pattern: "AKIA}",
+			err:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			generator, err := newPatternGenerator(tc.pattern)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected an error for pattern %q", tc.pattern)
+				}
+				return
+			}
+			assert.OK(t, err)
+
+			value, err := generator.Generate()
+			assert.OK(t, err)
+			if !regexp.MustCompile(tc.regex).Match(value) {
+				t.Errorf("generated value %q does not match %s", value, tc.regex)
+			}
+			assert.Equal(t, generator.Entropy(), tc.entropy)
+		})
+	}
+}
+
+func TestPassphraseGenerator(t *testing.T) {
+	generator := passphraseGenerator{
+		words:      5,
+		separator:  " ",
+		capitalize: true,
+	}
+
+	value, err := generator.Generate()
+	assert.OK(t, err)
+
+	words := strings.Split(string(value), " ")
+	assert.Equal(t, len(words), 5)
+	for _, word := range words {
+		if strings.ToUpper(word[:1]) != word[:1] {
+			t.Errorf("expected word %q to be capitalized", word)
+		}
+	}
+	assert.Equal(t, generator.Entropy(), 5*math.Log2(1296))
+}
+
+func TestRandomBytesGenerator(t *testing.T) {
+	cases := map[string]struct {
+		encoding string
+		decode   func(string) ([]byte, error)
+	}{
+		"hex": {
+			encoding: "hex",
+			decode:   hex.DecodeString,
+		},
+		"base64": {
+			encoding: "base64",
+			decode:   base64.StdEncoding.DecodeString,
+		},
+		"base64url": {
+			encoding: "base64url",
+			decode:   base64.RawURLEncoding.DecodeString,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			generator, err := newRandomBytesGenerator(32, tc.encoding)
+			assert.OK(t, err)
+
+			value, err := generator.Generate()
+			assert.OK(t, err)
+
+			decoded, err := tc.decode(string(value))
+			assert.OK(t, err)
+			assert.Equal(t, len(decoded), 32)
+			assert.Equal(t, generator.Entropy(), 256.0)
+		})
+	}
+}
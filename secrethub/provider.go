@@ -1,6 +1,8 @@
 package secrethub
 
 import (
+	"context"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/secrethub/secrethub-go/pkg/secrethub"
@@ -11,7 +13,7 @@ var version string
 
 // Provider returns the SecretHub Terraform provider
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"credential": {
 				Type:        schema.TypeString,
@@ -26,23 +28,38 @@ func Provider() terraform.ResourceProvider {
 				Description: "Passphrase to unlock the credential. Can also be sourced from SECRETHUB_CREDENTIAL_PASSPHRASE.",
 			},
 		},
-		ConfigureFunc: configureProvider,
 		ResourcesMap: map[string]*schema.Resource{
-			"secrethub_secret":      resourceSecret(),
-			"secrethub_dir":         resourceDir(),
-			"secrethub_access_rule": resourceAccessRule(),
-			"secrethub_service":     resourceService(),
-			"secrethub_service_aws": resourceServiceAWS(),
-			"secrethub_service_gcp": resourceServiceGCP(),
+			"secrethub_secret":          resourceSecret(),
+			"secrethub_dir":             resourceDir(),
+			"secrethub_access_rule":     resourceAccessRule(),
+			"secrethub_service":         resourceService(),
+			"secrethub_service_aws":     resourceServiceAWS(),
+			"secrethub_service_gcp":     resourceServiceGCP(),
+			"secrethub_certificate":     resourceCertificate(),
+			"secrethub_json_secret":     resourceJSONSecret(),
+			"secrethub_secret_rollback": resourceSecretRollback(),
+			"secrethub_local_file":      resourceLocalFile(),
+			"secrethub_dir_tree":        resourceDirTree(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"secrethub_secret": dataSourceSecret(),
-			"secrethub_dir":    dataSourceDir(),
+			"secrethub_secret":            dataSourceSecret(),
+			"secrethub_dir":               dataSourceDir(),
+			"secrethub_json_secret":       dataSourceJSONSecret(),
+			"secrethub_secret_versions":   dataSourceSecretVersions(),
+			"secrethub_env":               dataSourceEnv(),
+			"secrethub_env_file":          dataSourceEnvFile(),
+			"secrethub_template":          dataSourceTemplate(),
+			"secrethub_dir_document":      dataSourceDirDocument(),
+			"secrethub_kubernetes_secret": dataSourceKubernetesSecret(),
 		},
 	}
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return configureProvider(d, p.StopContext())
+	}
+	return p
 }
 
-func configureProvider(d *schema.ResourceData) (interface{}, error) {
+func configureProvider(d *schema.ResourceData, stopCtx context.Context) (interface{}, error) {
 	credRaw := d.Get("credential").(string)
 	passphrase := d.Get("credential_passphrase").(string)
 
@@ -69,9 +86,14 @@ func configureProvider(d *schema.ResourceData) (interface{}, error) {
 		return nil, err
 	}
 
-	return providerMeta{client}, nil
+	return providerMeta{
+		client:  client,
+		stopCtx: stopCtx,
+	}, nil
 }
 
 type providerMeta struct {
 	client *secrethub.Client
+	// stopCtx is cancelled when Terraform asks the provider to stop, e.g. on an interrupt.
+	stopCtx context.Context
 }
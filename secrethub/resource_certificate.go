@@ -0,0 +1,481 @@
+package secrethub
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/secrethub/secrethub-go/internals/api"
+)
+
+const (
+	certificateKeySuffix   = ".key"
+	certificateCertSuffix  = ".crt"
+	certificateChainSuffix = ".chain.crt"
+)
+
+func resourceCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceCertificateCreate,
+		Read:          resourceCertificateRead,
+		Update:        resourceCertificateUpdate,
+		Delete:        resourceCertificateDelete,
+		CustomizeDiff: resourceCertificateCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The base path of the certificate. The private key, certificate and chain are written to sibling secrets with the suffixes `.key`, `.crt` and `.chain.crt`.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The common name of the certificate subject.",
+			},
+			"organization": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The organization of the certificate subject.",
+			},
+			"dns_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The DNS names for which the certificate is valid.",
+			},
+			"ip_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IP addresses for which the certificate is valid.",
+			},
+			"is_ca_certificate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the generated certificate can be used to sign other certificates.",
+			},
+			"validity_period_hours": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of hours after creation that the certificate is valid.",
+			},
+			"early_renewal_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The number of hours before expiry in which the certificate is renewed on the next apply.",
+			},
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "RSA",
+				ValidateFunc: validation.StringInSlice([]string{"RSA", "ECDSA"}, false),
+				Description:  "The algorithm of the generated private key: RSA or ECDSA.",
+			},
+			"rsa_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     2048,
+				Description: "The size of the generated RSA key in bits.",
+			},
+			"ecdsa_curve": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "P256",
+				ValidateFunc: validation.StringInSlice([]string{"P224", "P256", "P384", "P521"}, false),
+				Description:  "The elliptic curve of the generated ECDSA key: P224, P256, P384 or P521.",
+			},
+			"ca_key_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of the secret containing the PEM encoded private key of the CA to sign the certificate with. When omitted, a self-signed certificate is generated.",
+			},
+			"ca_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The path of the secret containing the PEM encoded certificate of the CA. Required when `ca_key_path` is set.",
+			},
+			"key_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path of the secret containing the PEM encoded private key.",
+			},
+			"certificate_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path of the secret containing the PEM encoded certificate.",
+			},
+			"chain_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path of the secret containing the PEM encoded certificate chain.",
+			},
+			"certificate_pem": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate.",
+			},
+			"validity_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time from which the certificate is valid, in RFC3339 format.",
+			},
+			"validity_end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the certificate expires, in RFC3339 format.",
+			},
+			"ready_for_renewal": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the certificate is within its early renewal window and will be renewed on the next apply.",
+			},
+		},
+	}
+}
+
+func resourceCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Get("path").(string)
+	keyPath := path + certificateKeySuffix
+	certPath := path + certificateCertSuffix
+	chainPath := path + certificateChainSuffix
+
+	for _, p := range []string{keyPath, certPath, chainPath} {
+		err := api.ValidateSecretPath(p)
+		if err != nil {
+			return fmt.Errorf("invalid path %s: %s", p, err)
+		}
+	}
+
+	key, keyPEM, err := generateCertificateKey(d)
+	if err != nil {
+		return err
+	}
+
+	template, err := certificateTemplate(d)
+	if err != nil {
+		return err
+	}
+
+	parent := template
+	var signer crypto.Signer = key
+	var caChain []byte
+
+	caKeyPath := d.Get("ca_key_path").(string)
+	caCertPath := d.Get("ca_certificate_path").(string)
+	if caKeyPath != "" {
+		caKeyData, err := client.Secrets().Read(caKeyPath)
+		if err != nil {
+			return fmt.Errorf("cannot read CA key %s: %s", caKeyPath, err)
+		}
+		signer, err = parsePrivateKeyPEM(caKeyData.Data)
+		if err != nil {
+			return fmt.Errorf("cannot parse CA key %s: %s", caKeyPath, err)
+		}
+
+		caCertData, err := client.Secrets().Read(caCertPath)
+		if err != nil {
+			return fmt.Errorf("cannot read CA certificate %s: %s", caCertPath, err)
+		}
+		parent, err = parseCertificatePEM(caCertData.Data)
+		if err != nil {
+			return fmt.Errorf("cannot parse CA certificate %s: %s", caCertPath, err)
+		}
+		caChain = caCertData.Data
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	if err != nil {
+		return fmt.Errorf("cannot create certificate: %s", err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	chainPEM := append(append([]byte{}, certPEM...), caChain...)
+
+	_, err = client.Secrets().Write(keyPath, keyPEM)
+	if err != nil {
+		return err
+	}
+	_, err = client.Secrets().Write(certPath, certPEM)
+	if err != nil {
+		return err
+	}
+	_, err = client.Secrets().Write(chainPath, chainPEM)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	err = d.Set("key_path", keyPath)
+	if err != nil {
+		return err
+	}
+	err = d.Set("certificate_path", certPath)
+	if err != nil {
+		return err
+	}
+	err = d.Set("chain_path", chainPath)
+	if err != nil {
+		return err
+	}
+
+	return resourceCertificateRead(d, m)
+}
+
+func resourceCertificateRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	certPath := d.Id() + certificateCertSuffix
+
+	remote, err := client.Secrets().Read(certPath)
+	if api.IsErrNotFound(err) {
+		// The certificate was deleted outside of the current Terraform workspace, so invalidate this resource
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cert, err := parseCertificatePEM(remote.Data)
+	if err != nil {
+		return fmt.Errorf("cannot parse certificate %s: %s", certPath, err)
+	}
+
+	err = d.Set("certificate_pem", string(remote.Data))
+	if err != nil {
+		return err
+	}
+	err = d.Set("validity_start_time", cert.NotBefore.Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	err = d.Set("validity_end_time", cert.NotAfter.Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	err = d.Set("ready_for_renewal", certificateReadyForRenewal(cert.NotAfter, d.Get("early_renewal_hours").(int)))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	// The planned validity_end_time is unknown when a renewal is planned, so look at the previous state.
+	// The renewal window is computed again instead of using the stored ready_for_renewal, so that
+	// the same decision is made as in the plan.
+	oldEndTime, _ := d.GetChange("validity_end_time")
+	endTime, err := time.Parse(time.RFC3339, oldEndTime.(string))
+	renew := err == nil && certificateReadyForRenewal(endTime, d.Get("early_renewal_hours").(int))
+	if renew || certificateSettingsChanged(d) {
+		return resourceCertificateCreate(d, m)
+	}
+	return resourceCertificateRead(d, m)
+}
+
+func resourceCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Id()
+
+	for _, suffix := range []string{certificateChainSuffix, certificateCertSuffix, certificateKeySuffix} {
+		err := client.Secrets().Delete(path + suffix)
+		if err != nil && !api.IsErrNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resourceCertificateCustomizeDiff plans a renewal of the certificate when it has
+// entered its early renewal window or when any of its settings have changed.
+func resourceCertificateCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("ca_key_path") && d.NewValueKnown("ca_certificate_path") {
+		caKeySet := d.Get("ca_key_path").(string) != ""
+		caCertSet := d.Get("ca_certificate_path").(string) != ""
+		if caKeySet != caCertSet {
+			return fmt.Errorf("ca_key_path and ca_certificate_path must be set together")
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	// When nothing is known about the current certificate, it is only renewed when its settings change.
+	endTime, err := time.Parse(time.RFC3339, d.Get("validity_end_time").(string))
+	renew := err == nil && certificateReadyForRenewal(endTime, d.Get("early_renewal_hours").(int))
+
+	if renew || certificateSettingsChanged(d) {
+		for _, key := range []string{"certificate_pem", "validity_start_time", "validity_end_time"} {
+			err := d.SetNewComputed(key)
+			if err != nil {
+				return err
+			}
+		}
+		return d.SetNew("ready_for_renewal", false)
+	}
+
+	return nil
+}
+
+// certificateSettingsChanged returns whether any of the settings that end up
+// in the certificate or its key have changed.
+// Both the planned diff and the resource data during apply can be passed, so the plan and the apply agree.
+func certificateSettingsChanged(d interface{ HasChange(string) bool }) bool {
+	for _, key := range []string{
+		"common_name",
+		"organization",
+		"dns_names",
+		"ip_addresses",
+		"is_ca_certificate",
+		"validity_period_hours",
+		"key_algorithm",
+		"rsa_bits",
+		"ecdsa_curve",
+		"ca_key_path",
+		"ca_certificate_path",
+	} {
+		if d.HasChange(key) {
+			return true
+		}
+	}
+	return false
+}
+
+func certificateReadyForRenewal(notAfter time.Time, earlyRenewalHours int) bool {
+	renewAt := notAfter.Add(-time.Duration(earlyRenewalHours) * time.Hour)
+	return !time.Now().Before(renewAt)
+}
+
+func certificateTemplate(d *schema.ResourceData) (*x509.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("cannot generate serial number: %s", err)
+	}
+
+	subject := pkix.Name{
+		CommonName: d.Get("common_name").(string),
+	}
+	if organization := d.Get("organization").(string); organization != "" {
+		subject.Organization = []string{organization}
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               subject,
+		NotBefore:             now,
+		NotAfter:              now.Add(time.Duration(d.Get("validity_period_hours").(int)) * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+	}
+
+	for _, name := range d.Get("dns_names").([]interface{}) {
+		template.DNSNames = append(template.DNSNames, name.(string))
+	}
+	for _, raw := range d.Get("ip_addresses").([]interface{}) {
+		ip := net.ParseIP(raw.(string))
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address: %s", raw)
+		}
+		template.IPAddresses = append(template.IPAddresses, ip)
+	}
+
+	if d.Get("is_ca_certificate").(bool) {
+		template.IsCA = true
+		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	}
+
+	return template, nil
+}
+
+// generateCertificateKey generates a private key as configured and returns it together with its PEM encoding.
+func generateCertificateKey(d *schema.ResourceData) (crypto.Signer, []byte, error) {
+	switch d.Get("key_algorithm").(string) {
+	case "ECDSA":
+		var curve elliptic.Curve
+		switch d.Get("ecdsa_curve").(string) {
+		case "P224":
+			curve = elliptic.P224()
+		case "P384":
+			curve = elliptic.P384()
+		case "P521":
+			curve = elliptic.P521()
+		default:
+			curve = elliptic.P256()
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		der, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, nil, err
+		}
+		return key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+	default:
+		key, err := rsa.GenerateKey(rand.Reader, d.Get("rsa_bits").(int))
+		if err != nil {
+			return nil, nil, err
+		}
+		der := x509.MarshalPKCS1PrivateKey(key)
+		return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: der}), nil
+	}
+}
+
+func parsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded key found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+}
+
+func parseCertificatePEM(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
@@ -0,0 +1,120 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccResourceCertificate_selfSigned(t *testing.T) {
+	path := testAcc.repoPath + "/test_acc_ca"
+
+	config := fmt.Sprintf(`
+		resource "secrethub_certificate" "ca" {
+			path                  = "%v"
+			common_name           = "Test CA"
+			is_ca_certificate     = true
+			key_algorithm         = "ECDSA"
+			validity_period_hours = 24
+		}
+	`, path)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("secrethub_certificate.ca", "key_path", path+".key"),
+					resource.TestCheckResourceAttr("secrethub_certificate.ca", "certificate_path", path+".crt"),
+					resource.TestCheckResourceAttr("secrethub_certificate.ca", "chain_path", path+".chain.crt"),
+					resource.TestCheckResourceAttr("secrethub_certificate.ca", "ready_for_renewal", "false"),
+					checkCertificateExistsRemotely(path),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceCertificate_caSigned(t *testing.T) {
+	caPath := testAcc.repoPath + "/test_acc_ca"
+	path := testAcc.repoPath + "/test_acc_cert"
+
+	config := fmt.Sprintf(`
+		resource "secrethub_certificate" "ca" {
+			path                  = "%v"
+			common_name           = "Test CA"
+			is_ca_certificate     = true
+			validity_period_hours = 48
+		}
+
+		resource "secrethub_certificate" "server" {
+			path                  = "%v"
+			common_name           = "server.internal"
+			dns_names             = ["server.internal"]
+			ip_addresses          = ["10.0.0.1"]
+			validity_period_hours = 24
+			early_renewal_hours   = 4
+			ca_key_path           = secrethub_certificate.ca.key_path
+			ca_certificate_path   = secrethub_certificate.ca.certificate_path
+		}
+	`, caPath, path)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkCertificateExistsRemotely(path),
+					checkCertificateSignedBy(path, caPath),
+				),
+			},
+		},
+	})
+}
+
+func checkCertificateExistsRemotely(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := *testAccProvider.Meta().(providerMeta).client
+
+		for _, suffix := range []string{certificateKeySuffix, certificateCertSuffix, certificateChainSuffix} {
+			_, err := client.Secrets().Get(path + suffix)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func checkCertificateSignedBy(path string, caPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := *testAccProvider.Meta().(providerMeta).client
+
+		certData, err := client.Secrets().Read(path + certificateCertSuffix)
+		if err != nil {
+			return err
+		}
+		cert, err := parseCertificatePEM(certData.Data)
+		if err != nil {
+			return err
+		}
+
+		caData, err := client.Secrets().Read(caPath + certificateCertSuffix)
+		if err != nil {
+			return err
+		}
+		ca, err := parseCertificatePEM(caData.Data)
+		if err != nil {
+			return err
+		}
+
+		return cert.CheckSignatureFrom(ca)
+	}
+}
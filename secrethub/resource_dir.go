@@ -2,11 +2,20 @@ package secrethub
 
 import (
 	"fmt"
+	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/secrethub/secrethub-go/internals/api"
+	"github.com/secrethub/secrethub-go/pkg/secrethub"
 )
 
+// maxReportedForceDestroySecrets is the maximum number of secret paths that are kept in force_destroy_secrets,
+// so that the state does not grow with the size of the directory.
+const maxReportedForceDestroySecrets = 100
+
 func resourceDir() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDirCreate,
@@ -23,12 +32,54 @@ func resourceDir() *schema.Resource {
 				ForceNew:    true,
 				Description: "The path of the directory.",
 			},
+			"deletion_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validation.StringInSlice([]string{"delete", "retain"}, false),
+				Description:  "What happens to the directory in SecretHub when the resource is destroyed: `delete` deletes the directory, `retain` only removes it from the Terraform state.",
+			},
 			"force_destroy": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: "Whether to allow deleting this directory if it's not empty. When set to `false`, you'll get an error when trying to delete the directory if it still contains directories or secrets.",
 			},
+			"max_force_destroy_secrets": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of secrets that `force_destroy` is allowed to delete. Destroying the directory fails when it contains more secrets. Defaults to no limit.",
+			},
+			"force_destroy_secrets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The paths of the first 100 secrets, in alphabetical order, that would be deleted together with the directory. Only set when `force_destroy` is `true`.",
+			},
+			"force_destroy_secret_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of secrets that would be deleted together with the directory. Only set when `force_destroy` is `true`.",
+			},
+			"force_destroy_dir_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of subdirectories that would be deleted together with the directory. Only set when `force_destroy` is `true`.",
+			},
+			"create_parents":  createParentsSchema(),
+			"created_parents": createdParentsSchema(),
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to adopt the directory when it already exists, instead of failing. Set `deletion_policy` to `retain` to keep an adopted directory on destroy.",
+			},
+			"adopted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the directory already existed and was adopted because of `adopt_existing`.",
+			},
 		},
 	}
 }
@@ -39,12 +90,45 @@ func resourceDirCreate(d *schema.ResourceData, m interface{}) error {
 
 	path := d.Get("path").(string)
 
+	if d.Get("adopt_existing").(bool) {
+		exists, err := client.Dirs().Exists(path)
+		if err != nil {
+			return err
+		}
+		if exists {
+			log.Printf("[INFO] Directory %s already exists, adopting it", path)
+			d.SetId(path)
+			err = d.Set("adopted", true)
+			if err != nil {
+				return err
+			}
+			return resourceDirRead(d, m)
+		}
+	}
+
+	var created []string
+	if d.Get("create_parents").(bool) {
+		var err error
+		created, err = createParentDirs(client.Dirs(), path)
+		if err != nil {
+			return rollbackCreatedParentDirs(client.Dirs(), created, err)
+		}
+	}
+
 	_, err := client.Dirs().Create(path)
 	if err != nil {
-		return err
+		return rollbackCreatedParentDirs(client.Dirs(), created, err)
 	}
 
 	d.SetId(path)
+	err = d.Set("created_parents", created)
+	if err != nil {
+		return err
+	}
+	err = d.Set("adopted", false)
+	if err != nil {
+		return err
+	}
 
 	return resourceDirRead(d, m)
 }
@@ -55,7 +139,7 @@ func resourceDirRead(d *schema.ResourceData, m interface{}) error {
 
 	path := d.Id()
 
-	_, err := client.Dirs().GetTree(path, 0, true)
+	tree, err := client.Dirs().GetTree(path, 0, false)
 	if api.IsErrNotFound(err) {
 		// The directory was deleted outside of the current Terraform workspace, so invalidate this resource
 		d.SetId("")
@@ -65,6 +149,37 @@ func resourceDirRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("error fetching directory: %s", err)
 	}
 
+	// Destroy plans do not run CustomizeDiff, so what force_destroy would delete
+	// is reported on every refresh instead, so it shows up in the plan.
+	// The tree above already contains all descendants, so it is not fetched again.
+	var secretPaths []string
+	secretCount := 0
+	dirCount := 0
+	if d.Get("force_destroy").(bool) {
+		secretPaths, err = treeSecretPaths(tree)
+		if err != nil {
+			return err
+		}
+		secretCount = len(secretPaths)
+		dirCount = tree.DirCount()
+		if len(secretPaths) > maxReportedForceDestroySecrets {
+			secretPaths = secretPaths[:maxReportedForceDestroySecrets]
+		}
+	}
+
+	err = d.Set("force_destroy_secrets", secretPaths)
+	if err != nil {
+		return err
+	}
+	err = d.Set("force_destroy_secret_count", secretCount)
+	if err != nil {
+		return err
+	}
+	err = d.Set("force_destroy_dir_count", dirCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -78,10 +193,36 @@ func resourceDirDelete(d *schema.ResourceData, m interface{}) error {
 
 	path := d.Id()
 
+	if d.Get("deletion_policy").(string) == "retain" {
+		log.Printf("[WARN] Retaining directory %s in SecretHub, it is only removed from the Terraform state", path)
+		return nil
+	}
+
 	forceDestroy := d.Get("force_destroy").(bool)
+	maxSecrets := d.Get("max_force_destroy_secrets").(int)
+	if forceDestroy && maxSecrets > 0 {
+		secretPaths, _, err := dirContents(client.Dirs(), path)
+		if err != nil && !api.IsErrNotFound(err) {
+			return err
+		}
+		if len(secretPaths) > maxSecrets {
+			return fmt.Errorf("refusing to force destroy directory %s: it contains %d secrets, which is more than max_force_destroy_secrets (%d)", path, len(secretPaths), maxSecrets)
+		}
+	}
 
-	if !forceDestroy {
-		tree, err := client.Dirs().GetTree(path, 1, false)
+	err := deleteDir(client.Dirs(), path, forceDestroy)
+	if err != nil {
+		return err
+	}
+
+	return deleteCreatedParentDirs(client.Dirs(), d.Get("created_parents").([]interface{}))
+}
+
+// deleteDir deletes the directory at the given path. Unless force is set, the directory is only
+// deleted when it is empty. A directory that no longer exists is not considered an error.
+func deleteDir(dirs secrethub.DirService, path string, force bool) error {
+	if !force {
+		tree, err := dirs.GetTree(path, 1, false)
 		if api.IsErrNotFound(err) {
 			return nil
 		}
@@ -93,13 +234,137 @@ func resourceDirDelete(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	err := client.Dirs().Delete(path)
+	err := dirs.Delete(path)
 	if api.IsErrNotFound(err) {
 		return nil
 	}
 	return err
 }
 
+// dirContents returns the paths of all secrets in the directory at the given path and its
+// subdirectories, in alphabetical order, together with the number of subdirectories.
+func dirContents(dirs secrethub.DirService, path string) ([]string, int, error) {
+	tree, err := dirs.GetTree(path, -1, false)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	secretPaths, err := treeSecretPaths(tree)
+	if err != nil {
+		return nil, 0, err
+	}
+	return secretPaths, tree.DirCount(), nil
+}
+
+// treeSecretPaths returns the paths of all secrets in the given tree, in alphabetical order.
+func treeSecretPaths(tree *api.Tree) ([]string, error) {
+	secretPaths := make([]string, 0, tree.SecretCount())
+	for secretID := range tree.Secrets {
+		secretPath, err := tree.AbsSecretPath(secretID)
+		if err != nil {
+			return nil, err
+		}
+		secretPaths = append(secretPaths, secretPath.String())
+	}
+	sort.Strings(secretPaths)
+	return secretPaths, nil
+}
+
+func createParentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Whether to create missing parent directories, like `mkdir -p` does. The directories that are created are deleted on destroy when they are empty.",
+	}
+}
+
+func createdParentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "The paths of the parent directories that were created because of `create_parents`.",
+	}
+}
+
+// createParentDirs creates the missing parent directories of the directory or secret at the given path,
+// like mkdir -p does. It returns the paths of the directories that were created, parents first.
+func createParentDirs(dirs secrethub.DirService, path string) ([]string, error) {
+	elements := strings.Split(path, "/")
+
+	var created []string
+	// The first two elements are the namespace and the repository, which cannot be created.
+	for i := 3; i < len(elements); i++ {
+		parent := strings.Join(elements[:i], "/")
+
+		// When a directory has been created, its subdirectories cannot exist yet.
+		if len(created) == 0 {
+			exists, err := dirs.Exists(parent)
+			if err != nil {
+				return created, err
+			}
+			if exists {
+				continue
+			}
+		}
+
+		log.Printf("[INFO] Creating parent directory %s", parent)
+		_, err := dirs.Create(parent)
+		if err == api.ErrDirAlreadyExists {
+			// The directory was created by someone else in the meantime, so it is not ours to delete.
+			log.Printf("[INFO] Parent directory %s already exists", parent)
+			continue
+		}
+		if err != nil {
+			return created, err
+		}
+		created = append(created, parent)
+	}
+	return created, nil
+}
+
+// deleteCreatedParentDirs deletes the given parent directories that were created by createParentDirs,
+// deepest first. It stops at the first directory that is not empty, as its parents are not empty either.
+func deleteCreatedParentDirs(dirs secrethub.DirService, created []interface{}) error {
+	for i := len(created) - 1; i >= 0; i-- {
+		path := created[i].(string)
+
+		tree, err := dirs.GetTree(path, 1, false)
+		if api.IsErrNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if len(tree.Dirs) > 1 || len(tree.Secrets) > 0 {
+			log.Printf("[INFO] Keeping parent directory %s as it is not empty", path)
+			return nil
+		}
+
+		log.Printf("[INFO] Deleting parent directory %s", path)
+		err = dirs.Delete(path)
+		if err != nil && !api.IsErrNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollbackCreatedParentDirs deletes the parent directories that were created by createParentDirs
+// when creating the directory or secret itself failed, and returns cause.
+func rollbackCreatedParentDirs(dirs secrethub.DirService, created []string, cause error) error {
+	parents := make([]interface{}, len(created))
+	for i, parent := range created {
+		parents[i] = parent
+	}
+	err := deleteCreatedParentDirs(dirs, parents)
+	if err != nil {
+		return fmt.Errorf("%s; additionally, cannot delete the created parent directories: %s", cause, err)
+	}
+	return cause
+}
+
 func resourceDirImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	path := d.Id()
 
@@ -2,10 +2,12 @@ package secrethub
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/secrethub/secrethub-go/internals/assert"
 )
 
 func TestAccResourceDir(t *testing.T) {
@@ -29,12 +31,38 @@ func TestAccResourceDir(t *testing.T) {
 				ResourceName:            fmt.Sprintf("secrethub_dir.%v", testAcc.dirName),
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"force_destroy"},
+				ImportStateVerifyIgnore: []string{"force_destroy", "deletion_policy", "create_parents", "adopt_existing", "adopted"},
 			},
 		},
 	})
 }
 
+func TestAccResourceDir_retain(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path            = "%v"
+			deletion_policy = "retain"
+		}
+	`, testAcc.dirName, testAcc.dirPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			err := checkDirExistsRemotely(testAcc.dirPath)(s)
+			if err != nil {
+				return fmt.Errorf("expected directory to be retained: %s", err)
+			}
+			return client().Dirs().Delete(testAcc.dirPath)
+		},
+	})
+}
+
 func checkDirExistsRemotely(path string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := *testAccProvider.Meta().(providerMeta).client
@@ -51,3 +79,125 @@ func checkDirExistsRemotely(path string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func TestAccResourceDir_createParents(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path           = "%v/parent/child"
+			create_parents = true
+		}
+	`, testAcc.dirName, testAcc.dirPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkDirExistsRemotely(testAcc.dirPath+"/parent/child"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_dir.%v", testAcc.dirName), "created_parents.#", "2"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_dir.%v", testAcc.dirName), "created_parents.0", testAcc.dirPath),
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_dir.%v", testAcc.dirName), "created_parents.1", testAcc.dirPath+"/parent"),
+				),
+			},
+		},
+		CheckDestroy: checkDirNotExistsRemotely(testAcc.dirPath),
+	})
+}
+
+func TestAccResourceDir_adoptExisting(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path            = "%v"
+			adopt_existing  = true
+			deletion_policy = "retain"
+		}
+	`, testAcc.dirName, testAcc.dirPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)()
+			_, err := client().Dirs().Create(testAcc.dirPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkDirExistsRemotely(testAcc.dirPath),
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_dir.%v", testAcc.dirName), "adopted", "true"),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			err := checkDirExistsRemotely(testAcc.dirPath)(s)
+			if err != nil {
+				return fmt.Errorf("expected adopted directory to be retained: %s", err)
+			}
+			return client().Dirs().Delete(testAcc.dirPath)
+		},
+	})
+}
+
+func TestAccResourceDir_forceDestroyReport(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path                      = "%v"
+			force_destroy             = true
+			max_force_destroy_secrets = 1
+		}
+	`, testAcc.dirName, testAcc.dirPath)
+
+	configNoLimit := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path          = "%v"
+			force_destroy = true
+		}
+	`, testAcc.dirName, testAcc.dirPath)
+
+	resourceName := fmt.Sprintf("secrethub_dir.%v", testAcc.dirName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_secret_count", "0"),
+				),
+			},
+			{
+				// Secrets written outside of Terraform are reported on refresh.
+				PreConfig: func() {
+					_, err := client().Dirs().Create(testAcc.dirPath + "/nested")
+					assert.OK(t, err)
+					_, err = client().Secrets().Write(testAcc.dirPath+"/first", []byte("first"))
+					assert.OK(t, err)
+					_, err = client().Secrets().Write(testAcc.dirPath+"/nested/second", []byte("second"))
+					assert.OK(t, err)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_secret_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_dir_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_secrets.0", testAcc.dirPath+"/first"),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy_secrets.1", testAcc.dirPath+"/nested/second"),
+				),
+			},
+			{
+				Config:      config,
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("refusing to force destroy directory"),
+			},
+			{
+				Config: configNoLimit,
+			},
+		},
+		CheckDestroy: checkDirNotExistsRemotely(testAcc.dirPath),
+	})
+}
@@ -0,0 +1,340 @@
+package secrethub
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/secrethub/secrethub-go/internals/api"
+	"github.com/secrethub/secrethub-go/pkg/secrethub"
+)
+
+func resourceDirTree() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDirTreeCreate,
+		Read:   resourceDirTreeRead,
+		Update: resourceDirTreeUpdate,
+		Delete: resourceDirTreeDelete,
+		Schema: map[string]*schema.Schema{
+			"root": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the existing directory under which the directories are managed.",
+			},
+			"dirs": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRelativeDirPath,
+				},
+				Set:         schema.HashString,
+				Description: "The paths of the directories relative to `root`, such as `prd/db`. Their parent directories are created as well.",
+			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to allow deleting managed directories that still contain secrets. Directories that are not managed by this resource are never deleted.",
+			},
+			"managed_dirs": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The directories that are managed by this resource: the configured directories and the parent directories that were created by it. Only these directories are ever deleted.",
+			},
+			"extra_dirs": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The directories under `root` that are not in the configuration. They are reported, but never deleted.",
+			},
+		},
+	}
+}
+
+func resourceDirTreeCreate(d *schema.ResourceData, m interface{}) error {
+	root := d.Get("root").(string)
+
+	err := api.ValidateDirPath(root)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(root)
+
+	return resourceDirTreeUpdate(d, m)
+}
+
+func resourceDirTreeRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	root := d.Id()
+
+	actual, err := readDirTree(client.Dirs(), root)
+	if api.IsErrNotFound(err) {
+		// The root directory was deleted outside of the current Terraform workspace, so invalidate this resource
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Missing directories are left out, so they are created again.
+	var dirs []interface{}
+	for _, dir := range setToStrings(d.Get("dirs").(*schema.Set)) {
+		if _, ok := actual[dir]; ok {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	var managed []interface{}
+	for _, dir := range setToStrings(d.Get("managed_dirs").(*schema.Set)) {
+		if _, ok := actual[dir]; ok {
+			managed = append(managed, dir)
+		}
+	}
+
+	expected := expandDirTree(setToStrings(d.Get("dirs").(*schema.Set)))
+	var extra []interface{}
+	for dir := range actual {
+		if _, ok := expected[dir]; !ok {
+			log.Printf("[WARN] Directory %s exists under %s, but is not in the configuration", dir, root)
+			extra = append(extra, dir)
+		}
+	}
+
+	err = d.Set("dirs", schema.NewSet(schema.HashString, dirs))
+	if err != nil {
+		return err
+	}
+	err = d.Set("managed_dirs", schema.NewSet(schema.HashString, managed))
+	if err != nil {
+		return err
+	}
+	err = d.Set("extra_dirs", schema.NewSet(schema.HashString, extra))
+	if err != nil {
+		return err
+	}
+	return d.Set("root", root)
+}
+
+func resourceDirTreeUpdate(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	root := d.Id()
+
+	managed := make(map[string]struct{})
+	for _, dir := range setToStrings(d.Get("managed_dirs").(*schema.Set)) {
+		managed[dir] = struct{}{}
+	}
+
+	o, n := d.GetChange("dirs")
+	oldDirs := expandDirTree(setToStrings(o.(*schema.Set)))
+	newDirs := expandDirTree(setToStrings(n.(*schema.Set)))
+
+	// Only managed directories that are no longer in the configuration are deleted.
+	var removed []string
+	for dir := range oldDirs {
+		_, keep := newDirs[dir]
+		_, isManaged := managed[dir]
+		if !keep && isManaged {
+			removed = append(removed, dir)
+		}
+	}
+	err := deleteManagedDirs(client.Dirs(), root, removed, managed, d.Get("force_destroy").(bool))
+	if err != nil {
+		return setManagedDirs(d, managed, err)
+	}
+
+	actual, err := readDirTree(client.Dirs(), root)
+	if err != nil {
+		return setManagedDirs(d, managed, err)
+	}
+
+	var missing []string
+	for dir := range newDirs {
+		if _, ok := actual[dir]; !ok {
+			missing = append(missing, dir)
+		}
+	}
+	// Parents are created before their subdirectories.
+	sort.Slice(missing, func(i, j int) bool {
+		return dirDepth(missing[i]) < dirDepth(missing[j])
+	})
+	for _, dir := range missing {
+		path := root + "/" + dir
+		log.Printf("[INFO] Creating directory %s", path)
+		_, err := client.Dirs().Create(path)
+		if err != nil {
+			return setManagedDirs(d, managed, err)
+		}
+		managed[dir] = struct{}{}
+	}
+
+	// Configured directories are managed, even when they already existed.
+	for _, dir := range setToStrings(n.(*schema.Set)) {
+		managed[dir] = struct{}{}
+	}
+
+	err = setManagedDirs(d, managed, nil)
+	if err != nil {
+		return err
+	}
+
+	return resourceDirTreeRead(d, m)
+}
+
+func resourceDirTreeDelete(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	root := d.Id()
+
+	managed := make(map[string]struct{})
+	var dirs []string
+	for _, dir := range setToStrings(d.Get("managed_dirs").(*schema.Set)) {
+		managed[dir] = struct{}{}
+		dirs = append(dirs, dir)
+	}
+
+	err := deleteManagedDirs(client.Dirs(), root, dirs, managed, d.Get("force_destroy").(bool))
+	if err != nil {
+		return setManagedDirs(d, managed, err)
+	}
+	return nil
+}
+
+// deleteManagedDirs deletes the given directories under root, subdirectories first, and removes them
+// from managed. A directory that contains a subdirectory that is not managed is never deleted, not even
+// with force, so that directories of others are never deleted together with a managed directory.
+func deleteManagedDirs(dirs secrethub.DirService, root string, remove []string, managed map[string]struct{}, force bool) error {
+	if len(remove) == 0 {
+		return nil
+	}
+
+	actual, err := readDirTree(dirs, root)
+	if api.IsErrNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// All directories are checked before any is deleted, against the tree as it was before.
+	dir, unmanaged, ok := findUnmanagedSubdir(actual, managed, remove)
+	if ok {
+		return fmt.Errorf("cannot remove directory %s/%s: it contains directory %s/%s, which is not managed by this resource", root, dir, root, unmanaged)
+	}
+
+	sort.Slice(remove, func(i, j int) bool {
+		return dirDepth(remove[i]) > dirDepth(remove[j])
+	})
+	for _, dir := range remove {
+		path := root + "/" + dir
+		log.Printf("[INFO] Deleting directory %s", path)
+		err := deleteDir(dirs, path, force)
+		if err != nil {
+			return err
+		}
+		delete(managed, dir)
+	}
+	return nil
+}
+
+// findUnmanagedSubdir returns a directory to remove together with an existing subdirectory of it
+// that is not managed, if there is any.
+func findUnmanagedSubdir(actual map[string]struct{}, managed map[string]struct{}, remove []string) (string, string, bool) {
+	for _, dir := range remove {
+		for other := range actual {
+			if _, ok := managed[other]; !ok && strings.HasPrefix(other, dir+"/") {
+				return dir, other, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+// setManagedDirs records the managed directories in the state and returns cause, if any.
+// It is also called when an update fails halfway, so the state reflects what was created or deleted.
+func setManagedDirs(d *schema.ResourceData, managed map[string]struct{}, cause error) error {
+	dirs := make([]interface{}, 0, len(managed))
+	for dir := range managed {
+		dirs = append(dirs, dir)
+	}
+	err := d.Set("managed_dirs", schema.NewSet(schema.HashString, dirs))
+	if cause != nil {
+		return cause
+	}
+	return err
+}
+
+// readDirTree returns the paths of all directories under the directory at the given path,
+// relative to that directory.
+func readDirTree(dirs secrethub.DirService, path string) (map[string]struct{}, error) {
+	tree, err := dirs.GetTree(path, -1, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rootPath, err := tree.AbsDirPath(tree.RootDir.DirID)
+	if err != nil {
+		return nil, err
+	}
+	prefix := rootPath.String() + "/"
+
+	relativePaths := make(map[string]struct{}, len(tree.Dirs))
+	for dirID := range tree.Dirs {
+		dirPath, err := tree.AbsDirPath(dirID)
+		if err != nil {
+			return nil, err
+		}
+		if dirPath == rootPath {
+			continue
+		}
+		relativePaths[strings.TrimPrefix(dirPath.String(), prefix)] = struct{}{}
+	}
+	return relativePaths, nil
+}
+
+// expandDirTree returns the given relative directory paths together with all of their parent directories.
+func expandDirTree(dirs []string) map[string]struct{} {
+	expanded := make(map[string]struct{}, len(dirs))
+	for _, dir := range dirs {
+		elements := strings.Split(dir, "/")
+		for i := range elements {
+			expanded[strings.Join(elements[:i+1], "/")] = struct{}{}
+		}
+	}
+	return expanded
+}
+
+func dirDepth(dir string) int {
+	return strings.Count(dir, "/")
+}
+
+func setToStrings(set *schema.Set) []string {
+	strs := make([]string, set.Len())
+	for i, v := range set.List() {
+		strs[i] = v.(string)
+	}
+	return strs
+}
+
+func validateRelativeDirPath(v interface{}, k string) ([]string, []error) {
+	path := v.(string)
+	// The path is validated as if it were a directory in the root of a repository.
+	err := api.ValidateDirPath("namespace/repo/" + path)
+	if err != nil || path == "" || strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+		return nil, []error{fmt.Errorf("%s must be a relative directory path such as prd/db, got: %s", k, path)}
+	}
+	return nil, nil
+}
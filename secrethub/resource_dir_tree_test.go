@@ -0,0 +1,175 @@
+package secrethub
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestAccResourceDirTree(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path = "%v"
+		}
+
+		resource "secrethub_dir_tree" "test" {
+			root = secrethub_dir.%v.path
+			dirs = ["prd/db", "prd/api", "dev"]
+		}
+	`, testAcc.dirName, testAcc.dirPath, testAcc.dirName)
+
+	configRemoved := fmt.Sprintf(`
+		resource "secrethub_dir" "%v" {
+			path = "%v"
+		}
+
+		resource "secrethub_dir_tree" "test" {
+			root = secrethub_dir.%v.path
+			dirs = ["prd/db"]
+		}
+	`, testAcc.dirName, testAcc.dirPath, testAcc.dirName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkDirExistsRemotely(testAcc.dirPath+"/prd"),
+					checkDirExistsRemotely(testAcc.dirPath+"/prd/db"),
+					checkDirExistsRemotely(testAcc.dirPath+"/prd/api"),
+					checkDirExistsRemotely(testAcc.dirPath+"/dev"),
+					resource.TestCheckResourceAttr("secrethub_dir_tree.test", "dirs.#", "3"),
+					resource.TestCheckResourceAttr("secrethub_dir_tree.test", "managed_dirs.#", "4"),
+				),
+			},
+			{
+				// Directories created outside of Terraform are reported, but not deleted.
+				PreConfig: func() {
+					_, err := client().Dirs().Create(testAcc.dirPath + "/extra")
+					assert.OK(t, err)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkDirExistsRemotely(testAcc.dirPath+"/extra"),
+					resource.TestCheckResourceAttr("secrethub_dir_tree.test", "extra_dirs.#", "1"),
+					resource.TestCheckResourceAttr("secrethub_dir_tree.test", "managed_dirs.#", "4"),
+				),
+			},
+			{
+				PreConfig: func() {
+					err := client().Dirs().Delete(testAcc.dirPath + "/extra")
+					assert.OK(t, err)
+				},
+				Config: configRemoved,
+				Check: resource.ComposeTestCheckFunc(
+					checkDirExistsRemotely(testAcc.dirPath+"/prd/db"),
+					checkDirNotExistsRemotely(testAcc.dirPath+"/prd/api"),
+					checkDirNotExistsRemotely(testAcc.dirPath+"/dev"),
+				),
+			},
+		},
+	})
+}
+
+func checkDirNotExistsRemotely(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		exists, err := client().Dirs().Exists(path)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("expected directory %s to not exist", path)
+		}
+		return nil
+	}
+}
+
+func TestExpandDirTree(t *testing.T) {
+	expanded := expandDirTree([]string{"prd/db/main", "prd/api", "dev"})
+
+	dirs := make([]string, 0, len(expanded))
+	for dir := range expanded {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	assert.Equal(t, dirs, []string{"dev", "prd", "prd/api", "prd/db", "prd/db/main"})
+}
+
+func TestFindUnmanagedSubdir(t *testing.T) {
+	toSet := func(dirs ...string) map[string]struct{} {
+		set := make(map[string]struct{}, len(dirs))
+		for _, dir := range dirs {
+			set[dir] = struct{}{}
+		}
+		return set
+	}
+
+	cases := map[string]struct {
+		actual    map[string]struct{}
+		managed   map[string]struct{}
+		remove    []string
+		dir       string
+		unmanaged string
+		found     bool
+	}{
+		"nested removal": {
+			actual:  toSet("prd", "prd/db"),
+			managed: toSet("prd", "prd/db"),
+			remove:  []string{"prd", "prd/db"},
+		},
+		"sibling not removed": {
+			actual:  toSet("prd", "prd/db", "dev"),
+			managed: toSet("prd", "prd/db"),
+			remove:  []string{"prd/db"},
+		},
+		"unmanaged subdirectory": {
+			actual:    toSet("prd", "prd/db", "prd/other"),
+			managed:   toSet("prd", "prd/db"),
+			remove:    []string{"prd", "prd/db"},
+			dir:       "prd",
+			unmanaged: "prd/other",
+			found:     true,
+		},
+		"unmanaged directory with common prefix": {
+			actual:  toSet("prd", "prd-old"),
+			managed: toSet("prd"),
+			remove:  []string{"prd"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			dir, unmanaged, found := findUnmanagedSubdir(tc.actual, tc.managed, tc.remove)
+			assert.Equal(t, found, tc.found)
+			assert.Equal(t, dir, tc.dir)
+			assert.Equal(t, unmanaged, tc.unmanaged)
+		})
+	}
+}
+
+func TestValidateRelativeDirPath(t *testing.T) {
+	cases := map[string]bool{
+		"prd":         true,
+		"prd/db":      true,
+		"prd/db-main": true,
+		"":            false,
+		"/prd":        false,
+		"prd/":        false,
+		"prd//db":     false,
+		"prd/d b":     false,
+	}
+
+	for path, valid := range cases {
+		t.Run(path, func(t *testing.T) {
+			_, errs := validateRelativeDirPath(path, "dirs")
+			assert.Equal(t, len(errs) == 0, valid)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package secrethub
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/secrethub/secrethub-go/internals/api"
+)
+
+func resourceJSONSecret() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceJSONSecretCreate,
+		Read:          resourceJSONSecretRead,
+		Update:        resourceJSONSecretUpdate,
+		Delete:        resourceJSONSecretDelete,
+		CustomizeDiff: resourceJSONSecretCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceSecretImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path where the secret will be stored.",
+			},
+			"values": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys and values of the JSON document that are not sensitive.",
+			},
+			"sensitive_values": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys and values of the JSON document that are sensitive.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the secret.",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The canonical JSON document stored in the secret.",
+			},
+		},
+	}
+}
+
+func resourceJSONSecretCreate(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	document, err := jsonSecretDocument(d.Get("values").(map[string]interface{}), d.Get("sensitive_values").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	path := d.Get("path").(string)
+
+	res, err := client.Secrets().Write(path, document)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+	err = d.Set("value", string(document))
+	if err != nil {
+		return err
+	}
+	err = d.Set("version", res.Version)
+	if err != nil {
+		return err
+	}
+
+	return resourceJSONSecretRead(d, m)
+}
+
+func resourceJSONSecretRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Id()
+
+	remote, err := client.Secrets().Get(path)
+	if api.IsErrNotFound(err) {
+		// The secret was deleted outside of the current Terraform workspace, so invalidate this resource
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	prev := d.Get("version")
+	if prev != remote.LatestVersion {
+		// The secret has been updated outside of the current Terraform workspace, so the new secret version has to be fetched
+		updated, err := client.Secrets().Versions().GetWithData(path)
+		if err != nil {
+			return err
+		}
+
+		fields, err := parseJSONSecret(updated.Data)
+		if err != nil {
+			return fmt.Errorf("cannot parse secret %s: %s", path, err)
+		}
+
+		// Keys are only reported as not sensitive when they are known to be so.
+		// This includes keys that were added outside of Terraform.
+		known := d.Get("values").(map[string]interface{})
+		values := make(map[string]interface{})
+		sensitiveValues := make(map[string]interface{})
+		for key, value := range fields {
+			if _, ok := known[key]; ok {
+				values[key] = value
+			} else {
+				sensitiveValues[key] = value
+			}
+		}
+
+		err = d.Set("values", values)
+		if err != nil {
+			return err
+		}
+		err = d.Set("sensitive_values", sensitiveValues)
+		if err != nil {
+			return err
+		}
+		err = d.Set("value", string(updated.Data))
+		if err != nil {
+			return err
+		}
+		err = d.Set("version", updated.Version)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceJSONSecretUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceJSONSecretCreate(d, m)
+}
+
+func resourceJSONSecretDelete(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Id()
+
+	return client.Secrets().Delete(path)
+}
+
+// resourceJSONSecretCustomizeDiff rejects keys that are both sensitive and not sensitive
+// and plans a new JSON document when any of the keys or values change.
+func resourceJSONSecretCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("values") && d.NewValueKnown("sensitive_values") {
+		values := d.Get("values").(map[string]interface{})
+		sensitiveValues := d.Get("sensitive_values").(map[string]interface{})
+		for key := range values {
+			if _, ok := sensitiveValues[key]; ok {
+				return fmt.Errorf("key %s cannot be in both values and sensitive_values", key)
+			}
+		}
+	}
+
+	if d.HasChange("values") || d.HasChange("sensitive_values") {
+		return d.SetNewComputed("value")
+	}
+	return nil
+}
+
+// jsonSecretDocument merges the given maps into a canonical JSON document, which has its keys sorted.
+func jsonSecretDocument(values map[string]interface{}, sensitiveValues map[string]interface{}) ([]byte, error) {
+	fields := make(map[string]string, len(values)+len(sensitiveValues))
+	for key, value := range values {
+		fields[key] = value.(string)
+	}
+	for key, value := range sensitiveValues {
+		if _, ok := fields[key]; ok {
+			return nil, fmt.Errorf("key %s cannot be in both values and sensitive_values", key)
+		}
+		fields[key] = value.(string)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("at least one key must be defined in values or sensitive_values")
+	}
+
+	// encoding/json sorts the keys of maps, so the document does not depend on the order of the keys.
+	return json.Marshal(fields)
+}
+
+// parseJSONSecret parses a JSON object into a map of strings.
+// Values that are not strings are kept in their JSON representation.
+func parseJSONSecret(data []byte) (map[string]string, error) {
+	var raw map[string]json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(raw))
+	for key, value := range raw {
+		var str string
+		err = json.Unmarshal(value, &str)
+		if err != nil {
+			str = string(value)
+		}
+		fields[key] = str
+	}
+	return fields, nil
+}
+
+// sortedKeys returns the keys of the given map in alphabetical order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,85 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestAccResourceJSONSecret(t *testing.T) {
+	configInit := fmt.Sprintf(`
+		resource "secrethub_json_secret" "%v" {
+			path = "%v"
+			values = {
+				user = "db_user"
+				host = "db.internal"
+			}
+			sensitive_values = {
+				password = "secretpassword"
+			}
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	configReordered := fmt.Sprintf(`
+		resource "secrethub_json_secret" "%v" {
+			path = "%v"
+			sensitive_values = {
+				password = "secretpassword"
+			}
+			values = {
+				host = "db.internal"
+				user = "db_user"
+			}
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	resourceName := fmt.Sprintf("secrethub_json_secret.%v", testAcc.secretName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: configInit,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", `{"host":"db.internal","password":"secretpassword","user":"db_user"}`),
+					resource.TestCheckResourceAttr(resourceName, "values.host", "db.internal"),
+					resource.TestCheckResourceAttr(resourceName, "sensitive_values.password", "secretpassword"),
+					checkJSONSecretRemotely(testAcc.secretPath, `{"host":"db.internal","password":"secretpassword","user":"db_user"}`),
+				),
+			},
+			{
+				Config:   configReordered,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestParseJSONSecret(t *testing.T) {
+	fields, err := parseJSONSecret([]byte(`{"host":"db.internal","port":5432,"tls":true,"options":{"a":1}}`))
+	assert.OK(t, err)
+
+	assert.Equal(t, fields, map[string]string{
+		"host":    "db.internal",
+		"port":    "5432",
+		"tls":     "true",
+		"options": `{"a":1}`,
+	})
+}
+
+func checkJSONSecretRemotely(path string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		secret, err := client().Secrets().Read(path)
+		if err != nil {
+			return err
+		}
+		if string(secret.Data) != expected {
+			return fmt.Errorf("expected remote secret to be %s, got %s", expected, secret.Data)
+		}
+		return nil
+	}
+}
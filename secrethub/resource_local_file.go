@@ -0,0 +1,232 @@
+package secrethub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/secrethub/secrethub-go/internals/api"
+)
+
+func resourceLocalFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLocalFileCreate,
+		Read:   resourceLocalFileRead,
+		Delete: resourceLocalFileDelete,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the secret to write to the file.",
+			},
+			"version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSecretVersion,
+				Description:  "The version of the secret to write: a version number, `latest` or `previous`. Defaults to `latest`, in which case the file is rewritten when a new version of the secret is written.",
+			},
+			"filename": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the file to write the secret to. Missing parent directories are created with permission 0700.",
+			},
+			"file_permission": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "0600",
+				ValidateFunc: validateFilePermission,
+				Description:  "The permission of the file, in octal notation.",
+			},
+			"secret_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the secret that was written to the file.",
+			},
+			"content_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA256 checksum of the file contents, used to detect changes to the file.",
+			},
+		},
+	}
+}
+
+func resourceLocalFileCreate(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path, version, err := parseSecretVersionPath(d.Get("path").(string), d.Get("version").(string))
+	if err != nil {
+		return err
+	}
+
+	secret, err := getSecretVersion(client.Secrets(), path, version)
+	if err != nil {
+		return err
+	}
+
+	filename := d.Get("filename").(string)
+	perm, err := parseFilePermission(d.Get("file_permission").(string))
+	if err != nil {
+		return err
+	}
+
+	err = writeFileAtomic(filename, secret.Data, perm)
+	if err != nil {
+		return fmt.Errorf("cannot write secret %s to %s: %s", path, filename, err)
+	}
+
+	d.SetId(filename)
+	err = d.Set("secret_version", secret.Version)
+	if err != nil {
+		return err
+	}
+	err = d.Set("content_sha256", sha256Hex(secret.Data))
+	if err != nil {
+		return err
+	}
+
+	return resourceLocalFileRead(d, m)
+}
+
+func resourceLocalFileRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	filename := d.Id()
+
+	// Any change to the file outside of Terraform invalidates this resource, so that the file is written again.
+	info, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		log.Printf("[WARN] File %s no longer exists, removing it from the state", filename)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	perm, err := parseFilePermission(d.Get("file_permission").(string))
+	if err != nil {
+		return err
+	}
+	if info.Mode().Perm() != perm {
+		log.Printf("[WARN] The permission of file %s has changed to %#o, removing it from the state", filename, info.Mode().Perm())
+		d.SetId("")
+		return nil
+	}
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	if sha256Hex(content) != d.Get("content_sha256").(string) {
+		log.Printf("[WARN] The contents of file %s have changed, removing it from the state", filename)
+		d.SetId("")
+		return nil
+	}
+
+	// A file with the latest or previous version is outdated when a new version of the secret is written.
+	path, version, err := parseSecretVersionPath(d.Get("path").(string), d.Get("version").(string))
+	if err != nil {
+		return err
+	}
+	if version == "latest" || version == "previous" {
+		secret, err := client.Secrets().Get(path)
+		if api.IsErrNotFound(err) {
+			log.Printf("[WARN] Secret %s no longer exists, keeping file %s as is", path, filename)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		expected := secret.LatestVersion
+		if version == "previous" {
+			expected--
+		}
+		if expected != d.Get("secret_version").(int) {
+			log.Printf("[INFO] File %s contains version %d of secret %s instead of version %d, removing it from the state", filename, d.Get("secret_version").(int), path, expected)
+			d.SetId("")
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func resourceLocalFileDelete(d *schema.ResourceData, m interface{}) error {
+	err := os.Remove(d.Id())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to the file with the given name by writing it to a temporary file in
+// the same directory and renaming it, so the file never contains partially written contents.
+// The file has the given permission from the start, so the data is never readable by others.
+func writeFileAtomic(filename string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(filename)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(dir, "."+filepath.Base(filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	// Removing the temporary file fails once it has been renamed, which is fine.
+	defer os.Remove(tmp.Name())
+
+	err = tmp.Chmod(perm)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	_, err = tmp.Write(data)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Sync()
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filename)
+}
+
+func parseFilePermission(permission string) (os.FileMode, error) {
+	perm, err := strconv.ParseUint(permission, 8, 32)
+	if err != nil || perm > 0777 {
+		return 0, fmt.Errorf("invalid file permission %s: must be in octal notation, such as 0600", permission)
+	}
+	return os.FileMode(perm), nil
+}
+
+func validateFilePermission(v interface{}, k string) ([]string, []error) {
+	_, err := parseFilePermission(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s: %s", k, err)}
+	}
+	return nil, nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
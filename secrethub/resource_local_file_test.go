@@ -0,0 +1,117 @@
+package secrethub
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestAccResourceLocalFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrethub")
+	assert.OK(t, err)
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "secret.txt")
+
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path  = "%v"
+			value = "secretpassword"
+		}
+
+		resource "secrethub_local_file" "test" {
+			path     = secrethub_secret.%v.path
+			filename = "%v"
+		}
+	`, testAcc.secretName, testAcc.secretPath, testAcc.secretName, filename)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkLocalFile(filename, "secretpassword", 0600),
+					resource.TestCheckResourceAttr("secrethub_local_file.test", "secret_version", "1"),
+				),
+			},
+			{
+				// Changes to the file are detected and the file is written again.
+				PreConfig: func() {
+					err := ioutil.WriteFile(filename, []byte("changed"), 0644)
+					assert.OK(t, err)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkLocalFile(filename, "secretpassword", 0600),
+				),
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			_, err := os.Stat(filename)
+			if !os.IsNotExist(err) {
+				return fmt.Errorf("expected file %s to be removed", filename)
+			}
+			return nil
+		},
+	})
+}
+
+func checkLocalFile(filename string, expected string, perm os.FileMode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if info.Mode().Perm() != perm {
+			return fmt.Errorf("expected file permission %#o, got %#o", perm, info.Mode().Perm())
+		}
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		if string(content) != expected {
+			return fmt.Errorf("unexpected file contents %q", content)
+		}
+		return nil
+	}
+}
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrethub")
+	assert.OK(t, err)
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "nested", "secret.txt")
+
+	err = writeFileAtomic(filename, []byte("first"), 0600)
+	assert.OK(t, err)
+	err = writeFileAtomic(filename, []byte("second"), 0640)
+	assert.OK(t, err)
+
+	err = checkLocalFile(filename, "second", 0640)(nil)
+	assert.OK(t, err)
+
+	// No temporary files are left behind.
+	files, err := ioutil.ReadDir(filepath.Dir(filename))
+	assert.OK(t, err)
+	assert.Equal(t, len(files), 1)
+}
+
+func TestParseFilePermission(t *testing.T) {
+	perm, err := parseFilePermission("0640")
+	assert.OK(t, err)
+	assert.Equal(t, perm, os.FileMode(0640))
+
+	for _, invalid := range []string{"", "rw-------", "0800", "1777"} {
+		_, err := parseFilePermission(invalid)
+		if err == nil {
+			t.Errorf("expected an error for file permission %q", invalid)
+		}
+	}
+}
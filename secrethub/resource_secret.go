@@ -1,19 +1,28 @@
 package secrethub
 
 import (
+	"encoding/base64"
 	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/secrethub/secrethub-go/internals/api"
-	"github.com/secrethub/secrethub-go/pkg/randchar"
+	"github.com/secrethub/secrethub-go/pkg/secrethub"
+	"github.com/secrethub/secrethub-go/pkg/secrethub/iterator"
 )
 
 func resourceSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceSecretCreate,
-		Read:   resourceSecretRead,
-		Update: resourceSecretUpdate,
-		Delete: resourceSecretDelete,
+		Create:        resourceSecretCreate,
+		Read:          resourceSecretRead,
+		Update:        resourceSecretUpdate,
+		Delete:        resourceSecretDelete,
+		CustomizeDiff: resourceSecretCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceSecretImport,
 		},
@@ -21,34 +30,95 @@ func resourceSecret() *schema.Resource {
 			"path": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "The path where the secret will be stored.",
+				Description: "The path where the secret will be stored. Changing the path moves the secret, including all of its versions.",
 			},
+			"deletion_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "delete",
+				ValidateFunc: validation.StringInSlice([]string{"delete", "retain"}, false),
+				Description:  "What happens to the secret in SecretHub when the resource is destroyed: `delete` deletes the secret, `retain` only removes it from the Terraform state.",
+			},
+			"create_parents":  createParentsSchema(),
+			"created_parents": createdParentsSchema(),
 			"version": {
 				Type:        schema.TypeInt,
 				Computed:    true,
 				Description: "The version of the secret.",
 			},
+			"pinned_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "A specific version of the secret to report in `pinned_value`, while the latest version is left untouched. This version is never pruned by `keep_versions`.",
+			},
+			"pinned_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The contents of the version set in `pinned_version`.",
+			},
+			"pinned_value_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded contents of the version set in `pinned_version`.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the latest version of the secret was created, in RFC3339 format.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the latest version of the secret: ok or flagged.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the secret.",
+			},
+			"blind_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The blind name of the secret, as used by the SecretHub API.",
+			},
+			"keep_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of most recent versions of the secret to keep. Older versions are deleted after every write. When not set, all versions are kept.",
+			},
 			"value": {
 				Type:          schema.TypeString,
 				Computed:      true,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"generate"},
-				Description:   "The secret contents. Either `value` or `generate` must be defined.",
+				ConflictsWith: []string{"generate", "value_base64"},
+				Description:   "The secret contents. Exactly one of `value`, `value_base64` or `generate` must be defined.",
+			},
+			"value_base64": {
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"generate", "value"},
+				ValidateFunc:  validateBase64,
+				Description:   "The base64 encoded secret contents, for secrets with binary content. Exactly one of `value`, `value_base64` or `generate` must be defined.",
 			},
 			"generate": {
 				Type:          schema.TypeList,
 				Optional:      true,
 				MaxItems:      1,
-				Description:   "Settings for autogenerating a secret. Either `value` or `generate` must be defined.",
-				ConflictsWith: []string{"value"},
+				Description:   "Settings for autogenerating a secret. Exactly one of `value`, `value_base64` or `generate` must be defined.",
+				ConflictsWith: []string{"value", "value_base64"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"length": {
 							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "The length of the secret to generate.",
+							Optional:    true,
+							Description: "The length of the secret to generate. Exactly one of `length`, `passphrase`, `pattern` or `random_bytes` must be defined.",
 						},
 						"use_symbols": {
 							Type:        schema.TypeBool,
@@ -68,9 +138,82 @@ func resourceSecret() *schema.Resource {
 							Elem:        &schema.Schema{Type: schema.TypeInt},
 							Description: "Ensure that the generated secret contains at least n characters from the given character set. Note that adding constraints reduces the strength of the secret.",
 						},
+						"exclude_characters": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Characters that should never be used in the generated secret. Exclusions are applied after all charsets are combined.",
+						},
+						"exclude_similar": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to exclude look-alike characters (iIlL1oO0) from the generated secret.",
+						},
+						"passphrase": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Settings for generating a passphrase of randomly chosen words. Exactly one of `length`, `passphrase`, `pattern` or `random_bytes` must be defined.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"words": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The number of words in the passphrase.",
+									},
+									"separator": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "-",
+										Description: "The separator placed between the words.",
+									},
+									"capitalize": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Whether to capitalize the first letter of every word.",
+									},
+								},
+							},
+						},
+						"random_bytes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Settings for generating a number of random bytes in a text encoding. Exactly one of `length`, `passphrase`, `pattern` or `random_bytes` must be defined.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"length": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+										Description:  "The number of random bytes to generate.",
+									},
+									"encoding": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "hex",
+										ValidateFunc: validation.StringInSlice([]string{"hex", "base64", "base64url"}, false),
+										Description:  "The encoding of the random bytes: hex, base64 or base64url (without padding).",
+									},
+								},
+							},
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validatePattern,
+							Description:  "A pattern the generated secret should follow. Placeholders of the form {charset:n} are replaced by n random characters from the named charset, e.g. AKIA{uppercase:16}. The pattern must contain at least one placeholder. Exactly one of `length`, `passphrase`, `pattern` or `random_bytes` must be defined.",
+						},
 					},
 				},
 			},
+			"entropy": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "An estimate of the entropy of the generated secret in bits. Only set when the secret is generated.",
+			},
 		},
 	}
 }
@@ -80,54 +223,31 @@ func resourceSecretCreate(d *schema.ResourceData, m interface{}) error {
 	client := *provider.client
 
 	valueStr := d.Get("value").(string)
+	valueBase64 := d.Get("value_base64").(string)
 	generateList := d.Get("generate").([]interface{})
-	if valueStr == "" && len(generateList) == 0 {
-		return fmt.Errorf("either 'value' or 'generate' must be specified")
+	if valueStr == "" && valueBase64 == "" && len(generateList) == 0 {
+		return fmt.Errorf("one of 'value', 'value_base64' or 'generate' must be specified")
 	}
 
 	var value []byte
-	if valueStr != "" {
+	switch {
+	case valueBase64 != "" && (valueStr == "" || d.HasChange("value_base64")):
+		// Both attributes are computed from the remote secret, so only use value_base64 when it's the one being set.
+		decoded, err := base64.StdEncoding.DecodeString(valueBase64)
+		if err != nil {
+			return fmt.Errorf("invalid value_base64: %s", err)
+		}
+		value = decoded
+	case valueStr != "":
 		value = []byte(valueStr)
 	}
 
 	if len(generateList) > 0 {
-		settings := generateList[0].(map[string]interface{})
-		useSymbols := settings["use_symbols"].(bool)
-		length := settings["length"].(int)
-		charsetSet := settings["charsets"].(*schema.Set)
-		charsets := charsetSet.List()
-		charset := randchar.Charset{}
-		if len(charsets) == 0 {
-			charset = randchar.Alphanumeric
-		}
-		if useSymbols {
-			charset = charset.Add(randchar.Symbols)
-		}
-		for _, charsetName := range charsets {
-			set, found := randchar.CharsetByName(charsetName.(string))
-			if !found {
-				return fmt.Errorf("unknown charset: %s", charsetName)
-			}
-			charset = charset.Add(set)
-		}
-
-		minRuleMap := settings["min"].(map[string]interface{})
-		var minRules []randchar.Option
-		for charset, min := range minRuleMap {
-			n := min.(int)
-			set, found := randchar.CharsetByName(charset)
-			if !found {
-				return fmt.Errorf("unknown charset: %s", charset)
-			}
-			minRules = append(minRules, randchar.Min(n, set))
-		}
-
-		var err error
-		rand, err := randchar.NewRand(charset, minRules...)
+		generator, err := newSecretGenerator(generateList[0].(map[string]interface{}))
 		if err != nil {
 			return err
 		}
-		value, err = rand.Generate(length)
+		value, err = generator.Generate()
 		if err != nil {
 			return err
 		}
@@ -135,13 +255,28 @@ func resourceSecretCreate(d *schema.ResourceData, m interface{}) error {
 
 	path := d.Get("path").(string)
 
+	var created []string
+	if d.IsNewResource() && d.Get("create_parents").(bool) {
+		var err error
+		created, err = createParentDirs(client.Dirs(), path)
+		if err != nil {
+			return rollbackCreatedParentDirs(client.Dirs(), created, err)
+		}
+	}
+
 	res, err := client.Secrets().Write(path, value)
 	if err != nil {
-		return err
+		return rollbackCreatedParentDirs(client.Dirs(), created, err)
 	}
 
 	d.SetId(path)
-	err = d.Set("value", string(value))
+	if d.IsNewResource() {
+		err = d.Set("created_parents", created)
+		if err != nil {
+			return err
+		}
+	}
+	err = setSecretData(d, "value", value)
 	if err != nil {
 		return err
 	}
@@ -150,6 +285,13 @@ func resourceSecretCreate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// The secret has been written at this point, so failing to prune does not fail the write.
+	// The old versions are pruned again on the next write.
+	err = pruneSecretVersions(client.Secrets(), path, d.Get("keep_versions").(int), d.Get("pinned_version").(int))
+	if err != nil {
+		log.Printf("[WARN] Cannot prune old versions of secret %s: %s", path, err)
+	}
+
 	return resourceSecretRead(d, m)
 }
 
@@ -169,28 +311,144 @@ func resourceSecretRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	var latest *api.SecretVersion
 	prev := d.Get("version")
 	if prev != remote.LatestVersion {
 		// The secret has been updated outside of the current Terraform workspace, so the new secret version has to be fetched
-		updated, err := client.Secrets().Versions().GetWithData(path)
+		latest, err = client.Secrets().Versions().GetWithData(path)
 		if err != nil {
 			return err
 		}
-		err = d.Set("value", string(updated.Data))
+		err = setSecretData(d, "value", latest.Data)
 		if err != nil {
 			return err
 		}
-		err = d.Set("version", updated.Version)
+		err = d.Set("version", latest.Version)
+		if err != nil {
+			return err
+		}
+	} else {
+		latest, err = client.Secrets().Versions().GetWithoutData(fmt.Sprintf("%s:%d", path, remote.LatestVersion))
 		if err != nil {
 			return err
 		}
 	}
 
+	err = d.Set("secret_id", remote.SecretID.String())
+	if err != nil {
+		return err
+	}
+	err = d.Set("blind_name", remote.BlindName)
+	if err != nil {
+		return err
+	}
+	err = d.Set("created_at", latest.CreatedAt.Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	err = d.Set("status", latest.Status)
+	if err != nil {
+		return err
+	}
+
+	// The entropy only depends on the generate settings, so it is derived from them
+	// on every read. This also sets it for imported secrets, which are never generated.
+	entropy := 0.0
+	if generateList := d.Get("generate").([]interface{}); len(generateList) > 0 {
+		generator, err := newSecretGenerator(generateList[0].(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		entropy = generator.Entropy()
+	}
+	err = d.Set("entropy", entropy)
+	if err != nil {
+		return err
+	}
+
+	var pinnedData []byte
+	if pinnedVersion := d.Get("pinned_version").(int); pinnedVersion > 0 {
+		pinned, err := client.Secrets().Versions().GetWithData(fmt.Sprintf("%s:%d", path, pinnedVersion))
+		if err != nil {
+			return fmt.Errorf("cannot read pinned version %d of secret %s: %s", pinnedVersion, path, err)
+		}
+		pinnedData = pinned.Data
+	}
+	err = setSecretData(d, "pinned_value", pinnedData)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceSecretUpdate(d *schema.ResourceData, m interface{}) error {
-	return resourceSecretCreate(d, m)
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	if d.HasChange("path") {
+		oldPath, newPath := d.GetChange("path")
+
+		var created []string
+		if d.Get("create_parents").(bool) {
+			var err error
+			created, err = createParentDirs(client.Dirs(), newPath.(string))
+			if err != nil {
+				return rollbackCreatedParentDirs(client.Dirs(), created, err)
+			}
+		}
+
+		// The new path is only saved in the state once the move has completed,
+		// so that a failed move is retried on the next apply.
+		d.Partial(true)
+
+		version, err := moveSecret(client.Secrets(), oldPath.(string), newPath.(string))
+		if err != nil {
+			return rollbackCreatedParentDirs(client.Dirs(), created, err)
+		}
+
+		d.SetId(newPath.(string))
+		err = d.Set("version", version)
+		if err != nil {
+			return err
+		}
+
+		// The created parents of the old path that are also parents of the new path are kept.
+		// The others are cleaned up now, so that created_parents always is a single chain.
+		var createdParents, oldParents []interface{}
+		for _, parent := range d.Get("created_parents").([]interface{}) {
+			if strings.HasPrefix(newPath.(string), parent.(string)+"/") {
+				createdParents = append(createdParents, parent)
+			} else {
+				oldParents = append(oldParents, parent)
+			}
+		}
+		err = deleteCreatedParentDirs(client.Dirs(), oldParents)
+		if err != nil {
+			log.Printf("[WARN] Cannot delete the parent directories created for %s: %s", oldPath, err)
+		}
+		for _, parent := range created {
+			createdParents = append(createdParents, parent)
+		}
+		err = d.Set("created_parents", createdParents)
+		if err != nil {
+			return err
+		}
+		d.Partial(false)
+	}
+
+	if d.HasChange("value") || d.HasChange("value_base64") || d.HasChange("generate") {
+		return resourceSecretCreate(d, m)
+	}
+
+	if d.HasChange("path") || d.HasChange("keep_versions") {
+		err := pruneSecretVersions(client.Secrets(), d.Id(), d.Get("keep_versions").(int), d.Get("pinned_version").(int))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceSecretRead(d, m)
 }
 
 func resourceSecretDelete(d *schema.ResourceData, m interface{}) error {
@@ -199,7 +457,220 @@ func resourceSecretDelete(d *schema.ResourceData, m interface{}) error {
 
 	path := d.Id()
 
-	return client.Secrets().Delete(path)
+	if d.Get("deletion_policy").(string) == "retain" {
+		log.Printf("[WARN] Retaining secret %s in SecretHub, it is only removed from the Terraform state", path)
+		return nil
+	}
+
+	err := client.Secrets().Delete(path)
+	if err != nil {
+		return err
+	}
+
+	return deleteCreatedParentDirs(client.Dirs(), d.Get("created_parents").([]interface{}))
+}
+
+// resourceSecretCustomizeDiff plans the attributes derived from the secret contents
+// and validates the generate settings at plan time.
+func resourceSecretCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	// value and value_base64 are two representations of the same contents, so a change of one changes the other.
+	generateList := d.Get("generate").([]interface{})
+	switch {
+	case d.HasChange("generate") && len(generateList) > 0:
+		for _, key := range []string{"value", "value_base64"} {
+			err := d.SetNewComputed(key)
+			if err != nil {
+				return err
+			}
+		}
+	case d.HasChange("value"):
+		err := d.SetNewComputed("value_base64")
+		if err != nil {
+			return err
+		}
+	case d.HasChange("value_base64"):
+		err := d.SetNewComputed("value")
+		if err != nil {
+			return err
+		}
+	}
+
+	// A move copies the versions that are left, numbering them from 1 again,
+	// so a pinned version would refer to a different version after the move.
+	if d.Id() != "" && d.HasChange("path") && d.Get("pinned_version").(int) > 0 {
+		return fmt.Errorf("cannot move secret %s while pinned_version is set: the versions are renumbered by the move, remove pinned_version first", d.Id())
+	}
+
+	// The moved secret is a new secret, so everything that identifies it changes.
+	if d.Id() != "" && d.HasChange("path") {
+		for _, key := range []string{"version", "secret_id", "blind_name", "created_at"} {
+			err := d.SetNewComputed(key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("pinned_version") {
+		for _, key := range []string{"pinned_value", "pinned_value_base64"} {
+			err := d.SetNewComputed(key)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.NewValueKnown("generate") {
+		return nil
+	}
+
+	if len(generateList) == 0 || generateList[0] == nil {
+		if d.Id() != "" && d.HasChange("generate") {
+			return d.SetNew("entropy", 0.0)
+		}
+		return nil
+	}
+
+	generator, err := newSecretGenerator(generateList[0].(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("generate") {
+		return d.SetNew("entropy", generator.Entropy())
+	}
+	return nil
+}
+
+// moveSecret copies all versions of the secret at oldPath to newPath, in order, and then deletes the
+// secret at oldPath. It returns the latest version of the secret at newPath. When the move fails,
+// the copy at newPath is deleted again, leaving the secret at oldPath as it was.
+func moveSecret(secrets secrethub.SecretService, oldPath string, newPath string) (int, error) {
+	exists, err := secrets.Exists(newPath)
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		return 0, fmt.Errorf("cannot move secret %s to %s: a secret already exists at %s", oldPath, newPath, newPath)
+	}
+
+	versions, err := listSecretVersions(secrets, oldPath, true)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Printf("[INFO] Moving secret %s to %s (%d versions)", oldPath, newPath, len(versions))
+
+	latest := 0
+	for _, version := range versions {
+		res, err := secrets.Write(newPath, version.Data)
+		if err != nil {
+			return 0, rollbackMove(secrets, newPath, fmt.Errorf("cannot copy version %d of %s to %s: %s", version.Version, oldPath, newPath, err))
+		}
+		latest = res.Version
+	}
+
+	err = secrets.Delete(oldPath)
+	if err != nil {
+		return 0, rollbackMove(secrets, newPath, fmt.Errorf("cannot delete secret %s after copying it to %s: %s", oldPath, newPath, err))
+	}
+
+	return latest, nil
+}
+
+// rollbackMove deletes the partially copied secret at newPath after a failed move and returns the
+// error that caused the move to fail, so that the move can be retried on the next apply.
+func rollbackMove(secrets secrethub.SecretService, newPath string, cause error) error {
+	err := secrets.Delete(newPath)
+	if err != nil && !api.IsErrNotFound(err) {
+		return fmt.Errorf("%s; additionally, cannot delete the partial copy at %s: %s", cause, newPath, err)
+	}
+	return cause
+}
+
+// pruneSecretVersions deletes all but the newest keep versions of the secret at the given path.
+// The latest version and the given versions in use are never deleted. When keep is 0, all versions are kept.
+func pruneSecretVersions(secrets secrethub.SecretService, path string, keep int, inUse ...int) error {
+	if keep < 1 {
+		return nil
+	}
+
+	versions, err := listSecretVersions(secrets, path, false)
+	if err != nil {
+		return err
+	}
+	if len(versions) <= keep {
+		return nil
+	}
+
+	keepVersion := make(map[int]bool, len(inUse))
+	for _, version := range inUse {
+		keepVersion[version] = true
+	}
+
+	for _, version := range versions[:len(versions)-keep] {
+		if keepVersion[version.Version] {
+			continue
+		}
+		log.Printf("[DEBUG] Deleting version %d of secret %s", version.Version, path)
+		err = secrets.Versions().Delete(fmt.Sprintf("%s:%d", path, version.Version))
+		if err != nil && !api.IsErrNotFound(err) {
+			return fmt.Errorf("cannot delete version %d of secret %s: %s", version.Version, path, err)
+		}
+	}
+
+	return nil
+}
+
+// listSecretVersions returns all versions of the secret at the given path, sorted from oldest to newest.
+func listSecretVersions(secrets secrethub.SecretService, path string, withData bool) ([]api.SecretVersion, error) {
+	iter := secrets.Versions().Iterator(path, &secrethub.SecretVersionIteratorParams{
+		IncludeSensitiveData: withData,
+	})
+
+	var versions []api.SecretVersion
+	for {
+		version, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Version < versions[j].Version
+	})
+
+	return versions, nil
+}
+
+// setSecretData sets both the given value attribute and its _base64 counterpart to the given secret data.
+// Terraform strings cannot hold binary data, so the value attribute is left empty when the data is not valid UTF-8.
+func setSecretData(d *schema.ResourceData, key string, data []byte) error {
+	err := d.Set(key, secretDataString(data))
+	if err != nil {
+		return err
+	}
+	return d.Set(key+"_base64", base64.StdEncoding.EncodeToString(data))
+}
+
+// secretDataString returns the secret data as a string, or an empty string when the data is not valid UTF-8.
+func secretDataString(data []byte) string {
+	if !utf8.Valid(data) {
+		return ""
+	}
+	return string(data)
+}
+
+func validateBase64(v interface{}, k string) ([]string, []error) {
+	_, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s is not valid base64: %s", k, err)}
+	}
+	return nil, nil
 }
 
 func resourceSecretImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
@@ -0,0 +1,91 @@
+package secrethub
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/secrethub/secrethub-go/internals/api"
+)
+
+func resourceSecretRollback() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretRollbackCreate,
+		Read:   resourceSecretRollbackRead,
+		Delete: resourceSecretRollbackDelete,
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the secret to roll back.",
+			},
+			"restore_version": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The version of the secret to restore. Its contents are written as a new latest version of the secret.",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the secret that was written by the rollback.",
+			},
+		},
+	}
+}
+
+func resourceSecretRollbackCreate(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Get("path").(string)
+	restoreVersion := d.Get("restore_version").(int)
+
+	restore, err := client.Secrets().Versions().GetWithData(fmt.Sprintf("%s:%d", path, restoreVersion))
+	if err != nil {
+		return fmt.Errorf("cannot read version %d of secret %s: %s", restoreVersion, path, err)
+	}
+
+	res, err := client.Secrets().Write(path, restore.Data)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Rolled back secret %s to version %d as version %d", path, restoreVersion, res.Version)
+
+	d.SetId(fmt.Sprintf("%s:%d", path, res.Version))
+	err = d.Set("version", res.Version)
+	if err != nil {
+		return err
+	}
+
+	return resourceSecretRollbackRead(d, m)
+}
+
+func resourceSecretRollbackRead(d *schema.ResourceData, m interface{}) error {
+	provider := m.(providerMeta)
+	client := *provider.client
+
+	path := d.Get("path").(string)
+
+	_, err := client.Secrets().Get(path)
+	if api.IsErrNotFound(err) {
+		// The secret was deleted outside of the current Terraform workspace, so invalidate this resource
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceSecretRollbackDelete(d *schema.ResourceData, m interface{}) error {
+	// A rollback only adds a version to the secret, which is left in place so the secret's history is preserved.
+	log.Printf("[DEBUG] Removing rollback %s from the Terraform state, the secret itself is not changed", d.Id())
+	return nil
+}
@@ -0,0 +1,57 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestAccResourceSecretRollback(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret_rollback" "%v" {
+			path            = "%v"
+			restore_version = 1
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					// Write two versions of the secret outside of Terraform
+					_, err := client().Secrets().Write(testAcc.secretPath, []byte("secretpasswordv1"))
+					assert.OK(t, err)
+					_, err = client().Secrets().Write(testAcc.secretPath, []byte("secretpasswordv2"))
+					assert.OK(t, err)
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_secret_rollback.%v", testAcc.secretName), "version", "3"),
+					func(s *terraform.State) error {
+						secret, err := client().Secrets().Read(testAcc.secretPath)
+						if err != nil {
+							return err
+						}
+						if secret.Version != 3 || string(secret.Data) != "secretpasswordv1" {
+							return fmt.Errorf("expected version 3 to contain the contents of version 1, got version %d: %s", secret.Version, secret.Data)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Applying the rollback again should not write another version
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			return client().Secrets().Delete(testAcc.secretPath)
+		},
+	})
+}
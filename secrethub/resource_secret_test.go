@@ -1,7 +1,11 @@
 package secrethub
 
 import (
+	"bytes"
+	"encoding/base64"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/secrethub/secrethub-go/pkg/randchar"
@@ -173,6 +177,61 @@ func TestAccResourceSecret_generate(t *testing.T) {
 	})
 }
 
+func TestAccResourceSecret_generatePassphraseAndPattern(t *testing.T) {
+	configPassphrase := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			generate {
+				passphrase {
+					words      = 6
+					separator  = "."
+					capitalize = true
+				}
+			}
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	configPattern := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path = "%v"
+			generate {
+				pattern = "AKIA{uppercase:16}"
+			}
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: configPassphrase,
+				Check: resource.ComposeTestCheckFunc(
+					checkSecretResourceState(testAcc, func(s *terraform.InstanceState) error {
+						if len(strings.Split(s.Attributes["value"], ".")) != 6 {
+							return fmt.Errorf("expected 'value' to contain a 6 word passphrase")
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("secrethub_secret.%v", testAcc.secretName), "entropy"),
+					checkSecretExistsRemotely(testAcc),
+				),
+			},
+			{
+				Config: configPattern,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						fmt.Sprintf("secrethub_secret.%v", testAcc.secretName),
+						"value",
+						regexp.MustCompile(`^AKIA[A-Z]{16}$`),
+					),
+					checkSecretExistsRemotely(testAcc),
+				),
+			},
+		},
+	})
+}
+
 func containsOnly(value string, charset randchar.Charset) bool {
 	return randchar.NewCharset(value).IsSubset(charset)
 }
@@ -186,6 +245,230 @@ func containsAtLeast(str string, charset randchar.Charset, count int) bool {
 	return count <= 0
 }
 
+func TestAccResourceSecret_valueBase64(t *testing.T) {
+	// 0xff is never valid in UTF-8, so this value can only be managed through value_base64.
+	binaryValue := []byte{0x00, 0xff, 0x10, 0x80}
+
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path         = "%v"
+			value_base64 = "%v"
+		}
+	`, testAcc.secretName, testAcc.secretPath, base64.StdEncoding.EncodeToString(binaryValue))
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						fmt.Sprintf("secrethub_secret.%v", testAcc.secretName),
+						"value_base64",
+						base64.StdEncoding.EncodeToString(binaryValue),
+					),
+					func(s *terraform.State) error {
+						secret, err := client().Secrets().Read(testAcc.secretPath)
+						if err != nil {
+							return err
+						}
+						if !bytes.Equal(secret.Data, binaryValue) {
+							return fmt.Errorf("expected remote secret to contain %x, got %x", binaryValue, secret.Data)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecret_move(t *testing.T) {
+	newPath := testAcc.secretPath + "_moved"
+
+	configFor := func(path string, value string) string {
+		return fmt.Sprintf(`
+			resource "secrethub_secret" "%v" {
+				path = "%v"
+				value = "%v"
+			}
+		`, testAcc.secretName, path, value)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: configFor(testAcc.secretPath, "secretpasswordv1"),
+			},
+			{
+				Config: configFor(testAcc.secretPath, "secretpasswordv2"),
+			},
+			{
+				Config: configFor(newPath, "secretpasswordv2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_secret.%v", testAcc.secretName), "id", newPath),
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_secret.%v", testAcc.secretName), "version", "2"),
+					func(s *terraform.State) error {
+						exists, err := client().Secrets().Exists(testAcc.secretPath)
+						if err != nil {
+							return err
+						}
+						if exists {
+							return fmt.Errorf("expected secret to be removed from %s", testAcc.secretPath)
+						}
+
+						for version, expected := range []string{"secretpasswordv1", "secretpasswordv2"} {
+							secret, err := client().Secrets().Read(fmt.Sprintf("%s:%d", newPath, version+1))
+							if err != nil {
+								return err
+							}
+							if string(secret.Data) != expected {
+								return fmt.Errorf("expected version %d to be %s, got %s", version+1, expected, secret.Data)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecret_keepVersions(t *testing.T) {
+	configFor := func(value string) string {
+		return fmt.Sprintf(`
+			resource "secrethub_secret" "%v" {
+				path          = "%v"
+				value         = "%v"
+				keep_versions = 2
+			}
+		`, testAcc.secretName, testAcc.secretPath, value)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: configFor("secretpasswordv1"),
+			},
+			{
+				Config: configFor("secretpasswordv2"),
+			},
+			{
+				Config: configFor("secretpasswordv3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_secret.%v", testAcc.secretName), "version", "3"),
+					func(s *terraform.State) error {
+						versions, err := listSecretVersions(client().Secrets(), testAcc.secretPath, false)
+						if err != nil {
+							return err
+						}
+						if len(versions) != 2 || versions[0].Version != 2 || versions[1].Version != 3 {
+							return fmt.Errorf("expected only versions 2 and 3 to be kept, got %d versions", len(versions))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecret_pinnedVersion(t *testing.T) {
+	configFor := func(value string) string {
+		return fmt.Sprintf(`
+			resource "secrethub_secret" "%v" {
+				path           = "%v"
+				value          = "%v"
+				pinned_version = 1
+			}
+		`, testAcc.secretName, testAcc.secretPath, value)
+	}
+
+	resourceName := fmt.Sprintf("secrethub_secret.%v", testAcc.secretName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: configFor("secretpasswordv1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pinned_value", "secretpasswordv1"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ok"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "blind_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: configFor("secretpasswordv2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "value", "secretpasswordv2"),
+					resource.TestCheckResourceAttr(resourceName, "pinned_value", "secretpasswordv1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceSecret_retain(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path            = "%v"
+			value           = "secretpassword"
+			deletion_policy = "retain"
+		}
+	`, testAcc.secretName, testAcc.secretPath)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+		},
+		CheckDestroy: func(s *terraform.State) error {
+			err := checkSecretExistsRemotely(testAcc)(s)
+			if err != nil {
+				return fmt.Errorf("expected secret to be retained: %s", err)
+			}
+			return client().Secrets().Delete(testAcc.secretPath)
+		},
+	})
+}
+
+func TestAccResourceSecret_createParents(t *testing.T) {
+	config := fmt.Sprintf(`
+		resource "secrethub_secret" "%v" {
+			path           = "%v/nested/%v"
+			value          = "secretpassword"
+			create_parents = true
+		}
+	`, testAcc.secretName, testAcc.dirPath, testAcc.secretName)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		PreCheck:  testAccPreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					checkDirExistsRemotely(testAcc.dirPath+"/nested"),
+					resource.TestCheckResourceAttr(fmt.Sprintf("secrethub_secret.%v", testAcc.secretName), "created_parents.#", "2"),
+				),
+			},
+		},
+		CheckDestroy: checkDirNotExistsRemotely(testAcc.dirPath),
+	})
+}
+
 func TestAccResourceSecret_deleteDetection(t *testing.T) {
 	config := fmt.Sprintf(`
 		resource "secrethub_secret" "%v" {
@@ -231,9 +514,10 @@ func TestAccResourceSecret_import(t *testing.T) {
 				Config: config,
 			},
 			{
-				ResourceName:      fmt.Sprintf("secrethub_secret.%v", testAcc.secretName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            fmt.Sprintf("secrethub_secret.%v", testAcc.secretName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy", "create_parents"},
 			},
 		},
 	})
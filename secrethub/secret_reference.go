@@ -0,0 +1,77 @@
+package secrethub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/secrethub/secrethub-go/pkg/secrethub"
+)
+
+// secretReferencePrefix is the prefix of values that refer to a secret, as used by `secrethub run`.
+const secretReferencePrefix = "secrethub://"
+
+// secretResolver resolves secret paths to the contents of the secrets.
+// Every secret version is fetched only once, however often it is referenced.
+type secretResolver struct {
+	secrets secrethub.SecretService
+	cache   map[string]string
+}
+
+func newSecretResolver(secrets secrethub.SecretService) *secretResolver {
+	return &secretResolver{
+		secrets: secrets,
+		cache:   make(map[string]string),
+	}
+}
+
+// resolve returns the contents of the secret at the given path, which can
+// optionally end in a version, separated by a colon (path:version).
+func (r *secretResolver) resolve(ref string) (string, error) {
+	path, version, err := parseSecretVersionPath(ref, "")
+	if err != nil {
+		return "", err
+	}
+
+	key := path + ":" + version
+	if value, ok := r.cache[key]; ok {
+		return value, nil
+	}
+
+	secret, err := getSecretVersion(r.secrets, path, version)
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve secret %s: %s", ref, err)
+	}
+
+	value := string(secret.Data)
+	r.cache[key] = value
+	return value, nil
+}
+
+// resolveReference returns the contents of the referred secret when the value is a secret reference
+// of the form secrethub://path[:version]. Other values are returned unchanged.
+func (r *secretResolver) resolveReference(value string) (string, error) {
+	if !strings.HasPrefix(value, secretReferencePrefix) {
+		return value, nil
+	}
+	return r.resolve(strings.TrimPrefix(value, secretReferencePrefix))
+}
+
+// inputsID returns an ID for a data source that resolves secret references, derived from the
+// unresolved inputs so that it does not change when a secret is updated and does not leak any secret.
+func inputsID(text string, vars map[string]interface{}) string {
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha256.New()
+	fmt.Fprintln(h, text)
+	for _, name := range names {
+		fmt.Fprintf(h, "%s=%s\n", name, vars[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
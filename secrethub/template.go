@@ -0,0 +1,68 @@
+package secrethub
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// templateVarPattern matches template variables of the form ${name} in a secret path.
+var templateVarPattern = regexp.MustCompile(`\$\{\s*([^}]*?)\s*\}`)
+
+// templateVarNamePattern matches valid template variable names.
+var templateVarNamePattern = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+// renderSecretTemplate replaces every {{ path }} placeholder in the template with the contents
+// of the secret at that path, using the same syntax as the SecretHub CLI. A path can contain
+// template variables of the form ${name}, which are replaced by the given vars before the secret is resolved.
+func renderSecretTemplate(template string, vars map[string]string, resolve func(path string) (string, error)) (string, error) {
+	var rendered strings.Builder
+	rest := template
+	for {
+		start := strings.Index(rest, "{{")
+		if start == -1 {
+			rendered.WriteString(rest)
+			return rendered.String(), nil
+		}
+		end := strings.Index(rest[start:], "}}")
+		if end == -1 {
+			return "", fmt.Errorf("unclosed placeholder at position %d", len(template)-len(rest)+start)
+		}
+
+		path, err := injectTemplateVars(strings.TrimSpace(rest[start+2:start+end]), vars)
+		if err != nil {
+			return "", err
+		}
+		if path == "" {
+			return "", fmt.Errorf("empty placeholder at position %d", len(template)-len(rest)+start)
+		}
+
+		value, err := resolve(path)
+		if err != nil {
+			return "", err
+		}
+
+		rendered.WriteString(rest[:start])
+		rendered.WriteString(value)
+		rest = rest[start+end+2:]
+	}
+}
+
+// injectTemplateVars replaces the template variables in the given path by their values.
+func injectTemplateVars(path string, vars map[string]string) (string, error) {
+	var err error
+	injected := templateVarPattern.ReplaceAllStringFunc(path, func(match string) string {
+		name := templateVarPattern.FindStringSubmatch(match)[1]
+		if !templateVarNamePattern.MatchString(name) {
+			err = fmt.Errorf("invalid variable name %q in %s", name, path)
+			return match
+		}
+		value, ok := vars[name]
+		if !ok {
+			err = fmt.Errorf("undefined variable %s in %s", name, path)
+			return match
+		}
+		return value
+	})
+	return injected, err
+}
@@ -0,0 +1,82 @@
+package secrethub
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/secrethub/secrethub-go/internals/assert"
+)
+
+func TestRenderSecretTemplate(t *testing.T) {
+	secrets := map[string]string{
+		"company/repo/dev/db/user":     "admin",
+		"company/repo/dev/db/password": "secretpassword",
+		"company/repo/dev/db/port:2":   "5432",
+	}
+	resolve := func(path string) (string, error) {
+		value, ok := secrets[path]
+		if !ok {
+			return "", fmt.Errorf("secret %s not found", path)
+		}
+		return value, nil
+	}
+
+	cases := map[string]struct {
+		template string
+		vars     map[string]string
+		expected string
+		err      bool
+	}{
+		"no placeholders": {
+			template: "host: localhost",
+			expected: "host: localhost",
+		},
+		"placeholders": {
+			template: "user: {{ company/repo/dev/db/user }}\npassword: {{company/repo/dev/db/password}}\n",
+			expected: "user: admin\npassword: secretpassword\n",
+		},
+		"versioned path": {
+			template: "port: {{ company/repo/dev/db/port:2 }}",
+			expected: "port: 5432",
+		},
+		"variables": {
+			template: "password: {{ company/repo/${env}/db/${ name } }}",
+			vars:     map[string]string{"env": "dev", "name": "password"},
+			expected: "password: secretpassword",
+		},
+		"variables outside placeholders are kept": {
+			template: "home: ${HOME}",
+			expected: "home: ${HOME}",
+		},
+		"undefined variable": {
+			template: "password: {{ company/repo/${env}/db/password }}",
+			err:      true,
+		},
+		"unresolved reference": {
+			template: "password: {{ company/repo/prd/db/password }}",
+			err:      true,
+		},
+		"unclosed placeholder": {
+			template: "password: {{ company/repo/dev/db/password",
+			err:      true,
+		},
+		"empty placeholder": {
+			template: "password: {{ }}",
+			err:      true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rendered, err := renderSecretTemplate(tc.template, tc.vars, resolve)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("expected an error for template %q", tc.template)
+				}
+				return
+			}
+			assert.OK(t, err)
+			assert.Equal(t, rendered, tc.expected)
+		})
+	}
+}